@@ -0,0 +1,144 @@
+/*
+Package bbb provides a Go client for the BigBlueButton API.
+
+This file contains the checksum algorithms supported by BigBlueButton servers
+and the options for selecting or negotiating the algorithm used by a Client.
+*/
+
+package bbb
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+)
+
+// ChecksumAlgorithm identifies the hash function used to sign API calls.
+type ChecksumAlgorithm string
+
+// Checksum algorithms accepted by BigBlueButton, from weakest to strongest.
+const (
+	ChecksumSHA1   ChecksumAlgorithm = "sha1"
+	ChecksumSHA256 ChecksumAlgorithm = "sha256"
+	ChecksumSHA384 ChecksumAlgorithm = "sha384"
+	ChecksumSHA512 ChecksumAlgorithm = "sha512"
+)
+
+// checksumAlgorithms lists the supported algorithms ordered by strength.
+var checksumAlgorithms = []ChecksumAlgorithm{
+	ChecksumSHA1,
+	ChecksumSHA256,
+	ChecksumSHA384,
+	ChecksumSHA512,
+}
+
+// Valid reports whether the algorithm is supported by BigBlueButton.
+func (a ChecksumAlgorithm) Valid() bool {
+	for _, alg := range checksumAlgorithms {
+		if a == alg {
+			return true
+		}
+	}
+	return false
+}
+
+// newHash returns a new hash.Hash for the algorithm.
+func (a ChecksumAlgorithm) newHash() hash.Hash {
+	switch a {
+	case ChecksumSHA256:
+		return sha256.New()
+	case ChecksumSHA384:
+		return sha512.New384()
+	case ChecksumSHA512:
+		return sha512.New()
+	default:
+		return sha1.New()
+	}
+}
+
+// stronger returns the next stronger algorithm, if any.
+func (a ChecksumAlgorithm) stronger() (ChecksumAlgorithm, bool) {
+	for i, alg := range checksumAlgorithms {
+		if alg == a && i+1 < len(checksumAlgorithms) {
+			return checksumAlgorithms[i+1], true
+		}
+	}
+	return "", false
+}
+
+// WithChecksumAlgorithm sets the hash algorithm used to sign every API call,
+// including the join URLs built by JoinMeeting. The default is SHA-1.
+func WithChecksumAlgorithm(alg ChecksumAlgorithm) Option {
+	return func(c *Client) error {
+		if !alg.Valid() {
+			return fmt.Errorf("unsupported checksum algorithm: %q", alg)
+		}
+		c.checksumAlg = alg
+		return nil
+	}
+}
+
+// WithChecksumNegotiation enables retrying a call with a stronger checksum
+// algorithm when the server rejects it with a checksumError. Once a call
+// succeeds, the client keeps using the negotiated algorithm.
+func WithChecksumNegotiation(enabled bool) Option {
+	return func(c *Client) error {
+		c.negotiateChecksum = enabled
+		return nil
+	}
+}
+
+// ChecksumAlgorithm returns the algorithm the client currently signs calls with.
+func (c *Client) ChecksumAlgorithm() ChecksumAlgorithm {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.checksumAlg
+}
+
+// setChecksumAlgorithm records a negotiated checksum algorithm.
+func (c *Client) setChecksumAlgorithm(alg ChecksumAlgorithm) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checksumAlg = alg
+}
+
+// checksumLengths maps the hex length of a checksum to its algorithm.
+var checksumLengths = map[int]ChecksumAlgorithm{
+	40:  ChecksumSHA1,
+	64:  ChecksumSHA256,
+	96:  ChecksumSHA384,
+	128: ChecksumSHA512,
+}
+
+// VerifyChecksum reports whether an incoming API call carries a valid checksum
+// for secret. rawQuery is the query string as received, including the checksum
+// parameter; the algorithm is inferred from the checksum's length. It lets
+// services exposing the BigBlueButton API, such as load balancers, authenticate
+// their callers.
+func VerifyChecksum(action, rawQuery, secret string) bool {
+	var checksum string
+	var kept []string
+	for _, part := range strings.Split(rawQuery, "&") {
+		if v, ok := strings.CutPrefix(part, "checksum="); ok {
+			checksum = v
+			continue
+		}
+		if part != "" {
+			kept = append(kept, part)
+		}
+	}
+
+	alg, ok := checksumLengths[len(checksum)]
+	if !ok {
+		return false
+	}
+	h := alg.newHash()
+	h.Write([]byte(action + strings.Join(kept, "&") + secret))
+	expected := hex.EncodeToString(h.Sum(nil))
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(strings.ToLower(checksum))) == 1
+}
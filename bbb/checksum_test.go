@@ -0,0 +1,177 @@
+package bbb_test
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------- WithChecksumAlgorithm --------------------
+
+func TestWithChecksumAlgorithm_SignsRequests(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		checksum := query.Get("checksum")
+		query.Del("checksum")
+
+		sum := sha256.Sum256([]byte("getMeetings" + query.Encode() + "test-secret"))
+		assert.Equal(t, hex.EncodeToString(sum[:]), checksum)
+
+		w.Write([]byte(`<response><returncode>SUCCESS</returncode></response>`))
+	}))
+	defer ts.Close()
+
+	client, err := bbb.NewClient(ts.URL, "test-secret", bbb.WithChecksumAlgorithm(bbb.ChecksumSHA256))
+	require.NoError(t, err)
+
+	_, err = client.GetMeetings(context.Background())
+	require.NoError(t, err)
+}
+
+func TestWithChecksumAlgorithm_Invalid(t *testing.T) {
+	_, err := bbb.NewClient("https://example.com/bigbluebutton", "test-secret", bbb.WithChecksumAlgorithm("md5"))
+	require.Error(t, err)
+}
+
+func TestWithChecksumAlgorithm_JoinURL(t *testing.T) {
+	client, err := bbb.NewClient("https://example.com/bigbluebutton", "test-secret", bbb.WithChecksumAlgorithm(bbb.ChecksumSHA512))
+	require.NoError(t, err)
+
+	joinURL, err := client.JoinMeeting(context.Background(), &requests.JoinMeetingRequest{
+		MeetingID: "test123",
+		Password:  "mp",
+	})
+	require.NoError(t, err)
+
+	u, err := url.Parse(joinURL)
+	require.NoError(t, err)
+	assert.Len(t, u.Query().Get("checksum"), 128)
+}
+
+// -------------------- WithChecksumNegotiation --------------------
+
+func TestWithChecksumNegotiation_UpgradesAlgorithm(t *testing.T) {
+	var lengths []int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checksum := r.URL.Query().Get("checksum")
+		lengths = append(lengths, len(checksum))
+
+		// Only accept SHA-384 or stronger
+		if len(checksum) < 96 {
+			w.Write([]byte(`<response><returncode>FAILED</returncode><messageKey>checksumError</messageKey></response>`))
+			return
+		}
+		w.Write([]byte(`<response><returncode>SUCCESS</returncode></response>`))
+	}))
+	defer ts.Close()
+
+	client, err := bbb.NewClient(ts.URL, "test-secret", bbb.WithChecksumNegotiation(true))
+	require.NoError(t, err)
+
+	_, err = client.GetMeetings(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{40, 64, 96}, lengths)
+	assert.Equal(t, bbb.ChecksumSHA384, client.ChecksumAlgorithm())
+
+	// Subsequent calls start from the negotiated algorithm
+	_, err = client.GetMeetings(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{40, 64, 96, 96}, lengths)
+}
+
+func TestWithChecksumNegotiation_WrongSecret(t *testing.T) {
+	var lengths []int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lengths = append(lengths, len(r.URL.Query().Get("checksum")))
+		w.Write([]byte(`<response><returncode>FAILED</returncode><messageKey>checksumError</messageKey></response>`))
+	}))
+	defer ts.Close()
+
+	client, err := bbb.NewClient(ts.URL, "wrong-secret", bbb.WithChecksumNegotiation(true))
+	require.NoError(t, err)
+
+	_, err = client.GetMeetings(context.Background())
+	assert.ErrorIs(t, err, bbb.ErrAPIChecksum)
+	assert.Equal(t, []int{40, 64, 96, 128}, lengths)
+	assert.Equal(t, bbb.ChecksumSHA1, client.ChecksumAlgorithm(), "a rejected algorithm is not kept")
+}
+
+func TestWithChecksumNegotiation_JSONAndRaw(t *testing.T) {
+	var lengths []int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checksum := r.URL.Query().Get("checksum")
+		lengths = append(lengths, len(checksum))
+		if len(checksum) < 64 {
+			w.Write([]byte(`{"response":{"returncode":"FAILED","messageKey":"checksumError"}}`))
+			return
+		}
+		w.Write([]byte(`{"response":{"returncode":"SUCCESS","tracks":[]}}`))
+	}))
+	defer ts.Close()
+
+	client, err := bbb.NewClient(ts.URL, "test-secret", bbb.WithChecksumNegotiation(true))
+	require.NoError(t, err)
+	_, err = client.GetRecordingTextTracks(context.Background(), "rec-1")
+	require.NoError(t, err)
+	assert.Equal(t, []int{40, 64}, lengths)
+	assert.Equal(t, bbb.ChecksumSHA256, client.ChecksumAlgorithm())
+
+	raw, err := bbb.NewClient(ts.URL, "test-secret", bbb.WithChecksumNegotiation(true))
+	require.NoError(t, err)
+	_, err = raw.Call(context.Background(), "getRecordingTextTracks", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []int{40, 64, 40, 64}, lengths)
+	assert.Equal(t, bbb.ChecksumSHA256, raw.ChecksumAlgorithm())
+}
+
+func TestWithChecksumNegotiation_Disabled(t *testing.T) {
+	calls := 0
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`<response><returncode>FAILED</returncode><messageKey>checksumError</messageKey></response>`))
+	})
+
+	_, err := client.GetMeetings(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+// -------------------- VerifyChecksum --------------------
+
+func TestVerifyChecksum(t *testing.T) {
+	query := "meetingID=m1&name=Test+Meeting"
+	sha1Sum := sha1.Sum([]byte("create" + query + "secret"))
+	sha512Sum := sha512.Sum512([]byte("create" + query + "secret"))
+
+	tests := []struct {
+		name     string
+		action   string
+		rawQuery string
+		valid    bool
+	}{
+		{"sha1", "create", query + "&checksum=" + hex.EncodeToString(sha1Sum[:]), true},
+		{"sha512", "create", query + "&checksum=" + hex.EncodeToString(sha512Sum[:]), true},
+		{"checksum first", "create", "checksum=" + hex.EncodeToString(sha1Sum[:]) + "&" + query, true},
+		{"wrong action", "join", query + "&checksum=" + hex.EncodeToString(sha1Sum[:]), false},
+		{"tampered query", "create", "meetingID=m2&name=Test+Meeting&checksum=" + hex.EncodeToString(sha1Sum[:]), false},
+		{"missing checksum", "create", query, false},
+		{"unknown length", "create", query + "&checksum=abc", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.valid, bbb.VerifyChecksum(tt.action, tt.rawQuery, "secret"))
+		})
+	}
+}
@@ -8,17 +8,22 @@ configuring HTTP options, generating checksums, and making HTTP requests.
 package bbb
 
 import (
+	"bytes"
 	"context"
-	"crypto/sha1"
 	"encoding/hex"
+	"encoding/json"
 	"encoding/xml"
-	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
 )
 
 // Client represents a BigBlueButton API client.
@@ -26,6 +31,11 @@ type Client struct {
 	baseURL    string
 	secret     string
 	httpClient *http.Client
+	logger     *slog.Logger
+
+	mu                sync.RWMutex
+	checksumAlg       ChecksumAlgorithm
+	negotiateChecksum bool
 }
 
 // Option configures a Client.
@@ -49,6 +59,7 @@ func NewClient(baseURL, secret string, options ...Option) (*Client, error) {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		checksumAlg: ChecksumSHA1,
 	}
 
 	// Apply options
@@ -77,70 +88,207 @@ func WithTimeout(timeout time.Duration) Option {
 	}
 }
 
-// generateChecksum generates a checksum for the given API call and query parameters.
+// generateChecksum generates a checksum for the given API call and query parameters
+// using the client's current checksum algorithm.
 func (c *Client) generateChecksum(apiCall string, params url.Values) string {
+	return c.checksumWith(c.ChecksumAlgorithm(), apiCall, params)
+}
+
+// checksumWith generates a checksum for the given API call using the given algorithm.
+func (c *Client) checksumWith(alg ChecksumAlgorithm, apiCall string, params url.Values) string {
 	queryString := params.Encode()
-	sha := sha1.New()
-	sha.Write([]byte(apiCall + queryString + c.secret))
-	return hex.EncodeToString(sha.Sum(nil))
+	h := alg.newHash()
+	h.Write([]byte(apiCall + queryString + c.secret))
+	return hex.EncodeToString(h.Sum(nil))
 }
 
-// doRequest performs an HTTP request to the BigBlueButton API.
+// requestBody is the payload of a POST request to the BigBlueButton API.
+type requestBody struct {
+	contentType string
+	data        []byte
+}
+
+// doRequest performs an HTTP GET request to the BigBlueButton API.
 func (c *Client) doRequest(ctx context.Context, action string, params url.Values, result interface{}) error {
+	return c.doRequestWithBody(ctx, action, params, nil, result)
+}
+
+// doRequestWithBody performs an HTTP request to the BigBlueButton API, using POST when a payload is given.
+func (c *Client) doRequestWithBody(ctx context.Context, action string, params url.Values, payload *requestBody, result interface{}) error {
+	body, err := c.sendNegotiated(ctx, action, params, payload)
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(action, body, result)
+}
+
+// sendNegotiated sends a request with the client's checksum algorithm.
+// When checksum negotiation is enabled and the server rejects the checksum,
+// the request is retried with progressively stronger algorithms. The algorithm
+// is only kept for later calls once the server accepts it; if every algorithm
+// is rejected, e.g. because the secret is wrong, the client's algorithm is left
+// unchanged and the last response is returned.
+func (c *Client) sendNegotiated(ctx context.Context, action string, params url.Values, payload *requestBody) ([]byte, error) {
+	alg := c.ChecksumAlgorithm()
+	for {
+		body, err := c.send(ctx, alg, action, params, payload)
+		if err != nil || !c.negotiateChecksum {
+			return body, err
+		}
+
+		if isChecksumError(body) {
+			if next, ok := alg.stronger(); ok {
+				alg = next
+				continue
+			}
+			return body, nil
+		}
+
+		if alg != c.ChecksumAlgorithm() {
+			c.setChecksumAlgorithm(alg)
+		}
+		return body, nil
+	}
+}
+
+// isChecksumError reports whether an XML or JSON response body rejects the checksum.
+func isChecksumError(body []byte) bool {
+	var base responses.BaseResponseImpl
+	if xml.Unmarshal(body, &base) != nil {
+		var envelope struct {
+			Response responses.BaseResponseImpl `json:"response"`
+		}
+		if json.Unmarshal(body, &envelope) != nil {
+			return false
+		}
+		base = envelope.Response
+	}
+	return base.ReturnCode == "FAILED" && base.MessageKey == "checksumError"
+}
+
+// send signs the parameters with the given algorithm, performs the HTTP request and returns the response body.
+func (c *Client) send(ctx context.Context, alg ChecksumAlgorithm, action string, params url.Values, payload *requestBody) ([]byte, error) {
 	// Build the URL with the correct API path
 	u := fmt.Sprintf("%s%s", c.baseURL, action)
 
-	// Add checksum to parameters
-	checksum := c.generateChecksum(action, params)
-	params.Set("checksum", checksum)
+	// Add checksum to parameters, replacing any left over from a previous attempt.
+	// The API root, with an empty action, is the only call that is not signed.
+	params.Del("checksum")
+	if action != "" {
+		params.Set("checksum", c.checksumWith(alg, action, params))
+	}
 
 	// Build the full URL with query parameters
-	fullURL := fmt.Sprintf("%s?%s", u, params.Encode())
+	fullURL := u
+	if query := params.Encode(); query != "" {
+		fullURL += "?" + query
+	}
 
-	// Print the URL for debugging (remove in production)
-	fmt.Printf("Making request to: %s\n", fullURL)
+	// Create the request, sending the payload as the POST body if there is one
+	method := http.MethodGet
+	var reqBody io.Reader
+	if payload != nil {
+		method = http.MethodPost
+		reqBody = bytes.NewReader(payload.data)
+	}
+
+	c.logDebug(ctx, "bbb api request",
+		slog.String("action", action),
+		slog.String("method", method),
+		slog.String("url", redactURL(fullURL)),
+	)
 
-	// Create the request
-	req, err := http.NewRequestWithContext(ctx, "GET", fullURL, nil)
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
 	if err != nil {
-		return fmt.Errorf("creating request: %w", err)
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", payload.contentType)
 	}
 
 	// Make the request
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("making request: %w", err)
+		return nil, fmt.Errorf("making request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Read the response body for error details
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("reading response body: %w", err)
+		return nil, fmt.Errorf("reading response body: %w", err)
 	}
 
-	// Print the response status and body for debugging
-	fmt.Printf("Response Status: %d\n", resp.StatusCode)
-	fmt.Printf("Response Body: %s\n", string(body))
+	c.logDebug(ctx, "bbb api response",
+		slog.String("action", action),
+		slog.Int("status", resp.StatusCode),
+		slog.String("body", redactBody(body)),
+	)
 
-	// Check status code
+	// Check status code, keeping any messageKey the server reported
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d, response: %s", resp.StatusCode, string(body))
+		apiErr := &APIError{Action: action, StatusCode: resp.StatusCode, Message: string(body)}
+		var base responses.BaseResponseImpl
+		if xml.Unmarshal(body, &base) == nil && base.ReturnCode != "" {
+			apiErr.MessageKey = base.MessageKey
+			apiErr.Message = base.Message
+		}
+		return nil, apiErr
 	}
 
+	return body, nil
+}
+
+// doJSONRequest performs an HTTP GET request to an API endpoint that answers with
+// JSON wrapped in a "response" object, and decodes that object into result.
+func (c *Client) doJSONRequest(ctx context.Context, action string, params url.Values, result interface{}) error {
+	return c.doJSONRequestWithBody(ctx, action, params, nil, result)
+}
+
+// doJSONRequestWithBody is like doJSONRequest, using POST when a payload is given.
+func (c *Client) doJSONRequestWithBody(ctx context.Context, action string, params url.Values, payload *requestBody, result interface{}) error {
+	body, err := c.sendNegotiated(ctx, action, params, payload)
+	if err != nil {
+		return err
+	}
+
+	// Unwrap the response envelope
+	var envelope struct {
+		Response json.RawMessage `json:"response"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return fmt.Errorf("parsing response: %w, response body: %s", err, string(body))
+	}
+	if err := json.Unmarshal(envelope.Response, result); err != nil {
+		return fmt.Errorf("parsing response: %w, response body: %s", err, string(body))
+	}
+
+	return checkReturnCode(action, result)
+}
+
+// decodeResponse parses the XML response body into result and checks its return code.
+func decodeResponse(action string, body []byte, result interface{}) error {
 	// Parse the XML response
 	if err := xml.Unmarshal(body, result); err != nil {
 		return fmt.Errorf("parsing response: %w, response body: %s", err, string(body))
 	}
 
+	return checkReturnCode(action, result)
+}
+
+// checkReturnCode returns an APIError if the decoded response has a FAILED return code.
+func checkReturnCode(action string, result interface{}) error {
 	// Check for FAILED return code in the response
 	if response, ok := result.(interface{ GetReturnCode() string }); ok {
 		if returnCode := response.GetReturnCode(); returnCode == "FAILED" {
-			// If we have a message field, include it in the error
-			if responseWithMsg, ok := result.(interface{ GetMessage() string }); ok {
-				return fmt.Errorf("API request failed: %s", responseWithMsg.GetMessage())
+			apiErr := &APIError{Action: action, StatusCode: http.StatusOK}
+			// Keep the message key and message if the response carries them
+			if responseWithMsg, ok := result.(responses.BaseResponse); ok {
+				apiErr.MessageKey = responseWithMsg.GetMessageKey()
+				apiErr.Message = responseWithMsg.GetMessage()
 			}
-			return errors.New("API request failed")
+			return apiErr
 		}
 	}
 
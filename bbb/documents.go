@@ -0,0 +1,116 @@
+/*
+Package bbb provides functionality for uploading presentations to BigBlueButton.
+This file contains the insertDocument call and helpers for encoding documents in the XML
+body accepted by the create and insertDocument APIs.
+*/
+
+package bbb
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
+)
+
+// InsertDocument uploads additional presentations to a running meeting (BigBlueButton 2.5+).
+func (c *Client) InsertDocument(ctx context.Context, req *requests.InsertDocumentRequest) (*responses.InsertDocumentResponse, error) {
+	if req == nil {
+		return nil, NewError(ErrInvalidParam, "request cannot be nil")
+	}
+
+	// Validate required parameters
+	if req.MeetingID == "" {
+		return nil, NewError(ErrMissingParam, "meetingID is required")
+	}
+	if len(req.Documents) == 0 {
+		return nil, NewError(ErrMissingParam, "at least one document is required")
+	}
+
+	payload, err := presentationBody(req.Documents)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{
+		"meetingID": {req.MeetingID},
+	}
+
+	// Make the API call
+	var response responses.InsertDocumentResponse
+	if err := c.doRequestWithBody(ctx, "insertDocument", params, payload, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// xmlModules is the root element of a presentation upload body.
+type xmlModules struct {
+	XMLName xml.Name    `xml:"modules"`
+	Modules []xmlModule `xml:"module"`
+}
+
+// xmlModule groups the documents of a module.
+type xmlModule struct {
+	Name      string        `xml:"name,attr"`
+	Documents []xmlDocument `xml:"document"`
+}
+
+// xmlDocument is a document referenced by URL or embedded as base64 content.
+type xmlDocument struct {
+	URL          string `xml:"url,attr,omitempty"`
+	Filename     string `xml:"filename,attr,omitempty"`
+	Name         string `xml:"name,attr,omitempty"`
+	Current      string `xml:"current,attr,omitempty"`
+	Downloadable string `xml:"downloadable,attr,omitempty"`
+	Removable    string `xml:"removable,attr,omitempty"`
+	Content      string `xml:",chardata"`
+}
+
+// presentationBody encodes documents as a presentation module XML payload.
+func presentationBody(docs []requests.Document) (*requestBody, error) {
+	module := xmlModule{Name: "presentation"}
+	for i, doc := range docs {
+		d := xmlDocument{
+			Current:      optionalBoolAttr(doc.Current),
+			Downloadable: optionalBoolAttr(doc.Downloadable),
+			Removable:    optionalBoolAttr(doc.Removable),
+		}
+
+		switch {
+		case doc.URL != "":
+			d.URL = doc.URL
+			d.Filename = doc.Filename
+		case len(doc.Content) > 0:
+			if doc.Filename == "" {
+				return nil, NewError(ErrMissingParam, fmt.Sprintf("document %d: filename is required for inline content", i))
+			}
+			d.Name = doc.Filename
+			d.Content = base64.StdEncoding.EncodeToString(doc.Content)
+		default:
+			return nil, NewError(ErrMissingParam, fmt.Sprintf("document %d: url or content is required", i))
+		}
+
+		module.Documents = append(module.Documents, d)
+	}
+
+	data, err := xml.Marshal(xmlModules{Modules: []xmlModule{module}})
+	if err != nil {
+		return nil, fmt.Errorf("encoding documents: %w", err)
+	}
+
+	return &requestBody{contentType: "application/xml", data: data}, nil
+}
+
+// optionalBoolAttr converts an optional flag to an attribute value, empty when unset.
+func optionalBoolAttr(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return boolToStr(*b)
+}
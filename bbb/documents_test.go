@@ -0,0 +1,99 @@
+package bbb_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------- InsertDocument --------------------
+
+func TestInsertDocument_Success(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/insertDocument", r.URL.Path)
+		assert.Equal(t, "test123", r.URL.Query().Get("meetingID"))
+		assert.NotEmpty(t, r.URL.Query().Get("checksum"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `<modules><module name="presentation">`+
+			`<document url="https://example.com/quiz.pdf" filename="quiz.pdf" current="true"></document>`+
+			`<document name="extra.txt" downloadable="true" removable="true">U2xpZGU=</document>`+
+			`</module></modules>`, string(body))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`
+			<response>
+				<returncode>SUCCESS</returncode>
+				<message>Presentation is being uploaded</message>
+			</response>`))
+	})
+
+	quiz := requests.DocumentFromURL("https://example.com/quiz.pdf", "quiz.pdf")
+	quiz.Current = requests.Bool(true)
+	extra, err := requests.DocumentFromReader("extra.txt", strings.NewReader("Slide"))
+	require.NoError(t, err)
+	extra.Downloadable = requests.Bool(true)
+	extra.Removable = requests.Bool(true)
+
+	req := &requests.InsertDocumentRequest{
+		MeetingID: "test123",
+		Documents: []requests.Document{quiz, extra},
+	}
+	resp, err := client.InsertDocument(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "SUCCESS", resp.ReturnCode)
+	assert.Equal(t, "Presentation is being uploaded", resp.Message)
+
+	// The same request can be sent again, e.g. when retrying
+	_, err = client.InsertDocument(context.Background(), req)
+	require.NoError(t, err)
+}
+
+func TestInsertDocument_MeetingNotFound(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<response><returncode>FAILED</returncode><messageKey>notFound</messageKey></response>`))
+	})
+
+	_, err := client.InsertDocument(context.Background(), &requests.InsertDocumentRequest{
+		MeetingID: "missing",
+		Documents: []requests.Document{requests.DocumentFromURL("https://example.com/quiz.pdf", "quiz.pdf")},
+	})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, bbb.ErrAPINotFound))
+}
+
+func TestInsertDocument_ValidationErrors(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	})
+
+	tests := []struct {
+		name string
+		req  *requests.InsertDocumentRequest
+	}{
+		{"nil request", nil},
+		{"missing meetingID", &requests.InsertDocumentRequest{Documents: []requests.Document{{URL: "https://example.com/a.pdf"}}}},
+		{"missing documents", &requests.InsertDocumentRequest{MeetingID: "test123"}},
+		{"empty document", &requests.InsertDocumentRequest{MeetingID: "test123", Documents: []requests.Document{{}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.InsertDocument(context.Background(), tt.req)
+			require.Error(t, err)
+		})
+	}
+}
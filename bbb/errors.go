@@ -1,6 +1,10 @@
 package bbb
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
 
 // Error represents a BigBlueButton API error.
 type Error struct {
@@ -37,15 +41,102 @@ func NewError(code, message string) *Error {
 	}
 }
 
-// IsError checks if the error is a BigBlueButton error with the given code.
+// IsError checks if the error, or any error it wraps, is a BigBlueButton error with the given code.
+// API errors are matched through the code corresponding to their messageKey.
 func IsError(err error, code string) bool {
 	if err == nil {
 		return false
 	}
 
-	if bbbErr, ok := err.(*Error); ok {
+	var bbbErr *Error
+	if errors.As(err, &bbbErr) {
 		return bbbErr.Code == code
 	}
 
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code() == code
+	}
+
 	return false
 }
+
+// APIError represents a failed call reported by the BigBlueButton server,
+// either through a FAILED return code or an unexpected HTTP status.
+type APIError struct {
+	Action     string
+	MessageKey string
+	Message    string
+	StatusCode int
+}
+
+// Well-known message keys returned by the BigBlueButton API.
+// They can be matched with errors.Is, e.g. errors.Is(err, bbb.ErrAPINotFound).
+var (
+	ErrAPINotFound                 = &APIError{MessageKey: "notFound"}
+	ErrAPIChecksum                 = &APIError{MessageKey: "checksumError"}
+	ErrAPIIDNotUnique              = &APIError{MessageKey: "idNotUnique"}
+	ErrAPIInvalidMeetingIdentifier = &APIError{MessageKey: "invalidMeetingIdentifier"}
+	ErrAPISentEndMeetingRequest    = &APIError{MessageKey: "sentEndMeetingRequest"}
+	ErrAPIMaxParticipantsReached   = &APIError{MessageKey: "maxParticipantsReached"}
+)
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = e.MessageKey
+	}
+	if msg == "" && e.StatusCode != 0 {
+		msg = fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+	}
+	if msg == "" {
+		return "API request failed"
+	}
+
+	details := fmt.Sprintf("action: %s", e.Action)
+	if e.MessageKey != "" {
+		details += ", messageKey: " + e.MessageKey
+	}
+	if e.StatusCode != 0 {
+		details += fmt.Sprintf(", status: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("API request failed: %s (%s)", msg, details)
+}
+
+// Is reports whether the error matches target. Two API errors match when the
+// target's messageKey is set and equal, or when the target only carries an
+// HTTP status and the status codes are equal.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+	if t.MessageKey != "" {
+		return e.MessageKey == t.MessageKey
+	}
+	return t.StatusCode != 0 && e.StatusCode == t.StatusCode
+}
+
+// Code returns the generic error code corresponding to the error's messageKey.
+func (e *APIError) Code() string {
+	switch e.MessageKey {
+	case "notFound":
+		return ErrNotFound
+	case "checksumError":
+		return ErrChecksumMismatch
+	case "missingParam", "missingParamMeetingID":
+		return ErrMissingParam
+	case "invalidMeetingIdentifier":
+		return ErrInvalidParam
+	}
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusInternalServerError:
+		return ErrInternalServerError
+	}
+	return ErrRequestFailed
+}
@@ -0,0 +1,76 @@
+package bbb_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------- APIError --------------------
+
+func TestAPIError_FailedReturnCode(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`
+			<response>
+				<returncode>FAILED</returncode>
+				<messageKey>notFound</messageKey>
+				<message>We could not find a meeting with that meeting ID</message>
+			</response>`))
+	})
+
+	_, err := client.GetMeetingInfo(context.Background(), "test123", "mp")
+	require.Error(t, err)
+
+	var apiErr *bbb.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "getMeetingInfo", apiErr.Action)
+	assert.Equal(t, "notFound", apiErr.MessageKey)
+	assert.Equal(t, "We could not find a meeting with that meeting ID", apiErr.Message)
+	assert.Equal(t, http.StatusOK, apiErr.StatusCode)
+
+	assert.True(t, errors.Is(err, bbb.ErrAPINotFound))
+	assert.False(t, errors.Is(err, bbb.ErrAPIChecksum))
+	assert.True(t, bbb.IsError(err, bbb.ErrNotFound))
+}
+
+func TestAPIError_Wrapped(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<response><returncode>FAILED</returncode><messageKey>checksumError</messageKey></response>`))
+	})
+
+	_, err := client.GetMeetings(context.Background())
+	wrapped := fmt.Errorf("listing meetings: %w", err)
+
+	assert.True(t, errors.Is(wrapped, bbb.ErrAPIChecksum))
+	assert.True(t, bbb.IsError(wrapped, bbb.ErrChecksumMismatch))
+}
+
+func TestAPIError_HTTPStatus(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`Internal Server Error`))
+	})
+
+	_, err := client.GetMeetings(context.Background())
+
+	var apiErr *bbb.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+	assert.True(t, errors.Is(err, &bbb.APIError{StatusCode: http.StatusInternalServerError}))
+	assert.True(t, bbb.IsError(err, bbb.ErrInternalServerError))
+}
+
+func TestIsError_WrappedError(t *testing.T) {
+	err := fmt.Errorf("context: %w", bbb.NewError(bbb.ErrMissingParam, "meetingID is required"))
+
+	assert.True(t, bbb.IsError(err, bbb.ErrMissingParam))
+	assert.False(t, bbb.IsError(err, bbb.ErrInvalidParam))
+	assert.False(t, bbb.IsError(nil, bbb.ErrMissingParam))
+}
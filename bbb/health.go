@@ -0,0 +1,321 @@
+/*
+Package bbb provides a Go client for the BigBlueButton API.
+
+This file contains the health check of a single server and the monitor that
+tracks the health of the servers of a pool.
+*/
+
+package bbb
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
+)
+
+// Ping calls the API root, which needs no checksum, and returns the versions
+// the server reports. It is a cheap way to check that a server is alive.
+func (c *Client) Ping(ctx context.Context) (*responses.VersionResponse, error) {
+	var response responses.VersionResponse
+	if err := c.getRoot(ctx, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// getRoot performs an unsigned GET request to the API root and decodes the response into result.
+func (c *Client) getRoot(ctx context.Context, result interface{}) error {
+	body, err := c.send(ctx, c.ChecksumAlgorithm(), "", url.Values{}, nil)
+	if err != nil {
+		return err
+	}
+	return decodeResponse("", body, result)
+}
+
+// HealthState is the state of a server tracked by a HealthMonitor.
+type HealthState string
+
+// Health states of a server.
+const (
+	HealthUp       HealthState = "up"       // Answering health checks
+	HealthDown     HealthState = "down"     // Failed too many consecutive health checks
+	HealthDraining HealthState = "draining" // Taken out of rotation; keeps its running meetings
+)
+
+// ServerHealth is the health of a server as last checked.
+type ServerHealth struct {
+	Server              *Server
+	State               HealthState
+	Latency             time.Duration // Duration of the last successful check
+	ConsecutiveFailures int
+	LastError           error     // Error of the last failed check, cleared by a successful one
+	LastCheck           time.Time // Zero until the server has been checked
+	Version             string    // API version reported by the server
+}
+
+// HealthMonitor periodically pings servers and tracks whether they are up.
+// A server is down after FailureThreshold consecutive failed checks and up
+// again after a successful one. Servers can also be drained by hand, which
+// keeps them out of new placements whatever their checks say.
+type HealthMonitor struct {
+	servers   []*Server
+	threshold int
+	timeout   time.Duration
+	onChange  func(ServerHealth)
+	notifyMu  sync.Mutex // Serializes calls to onChange
+
+	mu       sync.RWMutex
+	health   map[string]*ServerHealth
+	draining map[string]bool
+}
+
+// HealthOption configures a HealthMonitor.
+type HealthOption func(*HealthMonitor) error
+
+// WithFailureThreshold sets the number of consecutive failed checks after which
+// a server is down. The default is 3.
+func WithFailureThreshold(n int) HealthOption {
+	return func(m *HealthMonitor) error {
+		if n < 1 {
+			return NewError(ErrInvalidParam, "failure threshold must be at least 1")
+		}
+		m.threshold = n
+		return nil
+	}
+}
+
+// WithCheckTimeout sets how long a single check may take. The default is 5 seconds.
+func WithCheckTimeout(timeout time.Duration) HealthOption {
+	return func(m *HealthMonitor) error {
+		if timeout <= 0 {
+			return NewError(ErrInvalidParam, "check timeout must be positive")
+		}
+		m.timeout = timeout
+		return nil
+	}
+}
+
+// WithHealthCallback sets a function called whenever the state of a server
+// changes. Calls are never concurrent: the changes found by a Check are
+// reported one after the other once every server has been checked. The
+// function is called synchronously and must not block.
+func WithHealthCallback(fn func(ServerHealth)) HealthOption {
+	return func(m *HealthMonitor) error {
+		m.onChange = fn
+		return nil
+	}
+}
+
+// NewHealthMonitor creates a monitor for the given servers, all considered up
+// until checked.
+func NewHealthMonitor(servers []*Server, options ...HealthOption) (*HealthMonitor, error) {
+	if len(servers) == 0 {
+		return nil, NewError(ErrMissingParam, "at least one server is required")
+	}
+
+	m := &HealthMonitor{
+		servers:   append([]*Server(nil), servers...),
+		threshold: 3,
+		timeout:   5 * time.Second,
+		health:    make(map[string]*ServerHealth, len(servers)),
+		draining:  make(map[string]bool),
+	}
+	for _, s := range servers {
+		if s == nil || s.Client == nil {
+			return nil, NewError(ErrInvalidParam, "server client cannot be nil")
+		}
+		if s.ID == "" {
+			return nil, NewError(ErrMissingParam, "server ID is required")
+		}
+		if m.health[s.ID] != nil {
+			return nil, NewError(ErrInvalidParam, "duplicate server ID: "+s.ID)
+		}
+		m.health[s.ID] = &ServerHealth{Server: s, State: HealthUp}
+	}
+
+	for _, option := range options {
+		if err := option(m); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// Check pings every server once, concurrently, and updates their health.
+func (m *HealthMonitor) Check(ctx context.Context) {
+	changes := make([]*ServerHealth, len(m.servers))
+	var wg sync.WaitGroup
+	for i, s := range m.servers {
+		wg.Add(1)
+		go func(i int, s *Server) {
+			defer wg.Done()
+			if snapshot, changed := m.check(ctx, s); changed {
+				changes[i] = &snapshot
+			}
+		}(i, s)
+	}
+	wg.Wait()
+
+	for _, h := range changes {
+		if h != nil {
+			m.notify(*h)
+		}
+	}
+}
+
+// check pings a server, records the outcome and returns the new health of the
+// server and whether its state changed.
+func (m *HealthMonitor) check(ctx context.Context, s *Server) (ServerHealth, bool) {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	start := time.Now()
+	resp, err := s.Client.Ping(ctx)
+	latency := time.Since(start)
+
+	m.mu.Lock()
+	h := m.health[s.ID]
+	h.LastCheck = time.Now()
+	if err != nil {
+		h.ConsecutiveFailures++
+		h.LastError = err
+	} else {
+		h.ConsecutiveFailures = 0
+		h.LastError = nil
+		h.Latency = latency
+		h.Version = resp.APIVersion
+	}
+	changed := m.updateState(h)
+	snapshot := *h
+	m.mu.Unlock()
+
+	return snapshot, changed
+}
+
+// notify passes a state change to the health callback, if set.
+func (m *HealthMonitor) notify(h ServerHealth) {
+	if m.onChange == nil {
+		return
+	}
+	m.notifyMu.Lock()
+	defer m.notifyMu.Unlock()
+	m.onChange(h)
+}
+
+// updateState derives the state of a server from its checks and whether it is
+// draining, and reports whether the state changed. m.mu must be held.
+func (m *HealthMonitor) updateState(h *ServerHealth) bool {
+	state := HealthUp
+	switch {
+	case m.draining[h.Server.ID]:
+		state = HealthDraining
+	case h.ConsecutiveFailures >= m.threshold:
+		state = HealthDown
+	}
+	if state == h.State {
+		return false
+	}
+	h.State = state
+	return true
+}
+
+// Run checks the servers immediately and then every interval until ctx is
+// done, which is the only way it returns.
+func (m *HealthMonitor) Run(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return NewError(ErrInvalidParam, "interval must be positive")
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		m.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Drain takes a server out of rotation: strategies wrapped by the monitor stop
+// placing new meetings on it, while its running meetings are still served.
+func (m *HealthMonitor) Drain(serverID string) error {
+	return m.setDraining(serverID, true)
+}
+
+// Enable puts a drained server back into rotation.
+func (m *HealthMonitor) Enable(serverID string) error {
+	return m.setDraining(serverID, false)
+}
+
+// setDraining changes whether a server is draining.
+func (m *HealthMonitor) setDraining(serverID string, draining bool) error {
+	m.mu.Lock()
+	h, ok := m.health[serverID]
+	if !ok {
+		m.mu.Unlock()
+		return NewError(ErrInvalidParam, "unknown server: "+serverID)
+	}
+	if draining {
+		m.draining[serverID] = true
+	} else {
+		delete(m.draining, serverID)
+	}
+	changed := m.updateState(h)
+	snapshot := *h
+	m.mu.Unlock()
+
+	if changed {
+		m.notify(snapshot)
+	}
+	return nil
+}
+
+// Health returns the health of a server.
+func (m *HealthMonitor) Health(serverID string) (ServerHealth, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	h, ok := m.health[serverID]
+	if !ok {
+		return ServerHealth{}, false
+	}
+	return *h, true
+}
+
+// All returns the health of every server, in the order they were given.
+func (m *HealthMonitor) All() []ServerHealth {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	out := make([]ServerHealth, len(m.servers))
+	for i, s := range m.servers {
+		out[i] = *m.health[s.ID]
+	}
+	return out
+}
+
+// Strategy wraps a strategy so that it only picks servers that are up.
+// ErrNoServer is returned when none is.
+func (m *HealthMonitor) Strategy(next Strategy) Strategy {
+	return StrategyFunc(func(ctx context.Context, servers []*Server) (*Server, error) {
+		m.mu.RLock()
+		up := make([]*Server, 0, len(servers))
+		for _, s := range servers {
+			if h, ok := m.health[s.ID]; !ok || h.State == HealthUp {
+				up = append(up, s)
+			}
+		}
+		m.mu.RUnlock()
+
+		if len(up) == 0 {
+			return nil, ErrNoServer
+		}
+		return next.Pick(ctx, up)
+	})
+}
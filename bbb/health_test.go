@@ -0,0 +1,205 @@
+package bbb_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// healthServer returns a server answering the API root while healthy is true.
+func healthServer(t *testing.T, id string, healthy *atomic.Bool) *bbb.Server {
+	t.Helper()
+
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/", r.URL.Path)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`<response><returncode>SUCCESS</returncode><version>2.0</version><apiVersion>2.0</apiVersion></response>`))
+	})
+	return &bbb.Server{ID: id, Client: client}
+}
+
+// -------------------- Ping --------------------
+
+func TestPing(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/", r.URL.Path)
+		assert.Empty(t, r.URL.RawQuery, "the API root is not signed")
+		w.Write([]byte(`<response><returncode>SUCCESS</returncode><version>2.0</version><apiVersion>2.0</apiVersion></response>`))
+	})
+
+	resp, err := client.Ping(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "2.0", resp.Version)
+	assert.Equal(t, "2.0", resp.APIVersion)
+}
+
+func TestPing_Failure(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.Ping(context.Background())
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, &bbb.APIError{StatusCode: http.StatusServiceUnavailable}))
+}
+
+// -------------------- HealthMonitor --------------------
+
+func TestHealthMonitor_States(t *testing.T) {
+	var aHealthy, bHealthy atomic.Bool
+	aHealthy.Store(true)
+	bHealthy.Store(true)
+	a := healthServer(t, "a", &aHealthy)
+	b := healthServer(t, "b", &bHealthy)
+
+	var mu sync.Mutex
+	var changes []string
+	m, err := bbb.NewHealthMonitor([]*bbb.Server{a, b},
+		bbb.WithFailureThreshold(2),
+		bbb.WithHealthCallback(func(h bbb.ServerHealth) {
+			mu.Lock()
+			changes = append(changes, h.Server.ID+":"+string(h.State))
+			mu.Unlock()
+		}),
+	)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	m.Check(ctx)
+	h, ok := m.Health("a")
+	require.True(t, ok)
+	assert.Equal(t, bbb.HealthUp, h.State)
+	assert.Equal(t, "2.0", h.Version)
+	assert.False(t, h.LastCheck.IsZero())
+
+	// b goes down after two failed checks.
+	bHealthy.Store(false)
+	m.Check(ctx)
+	h, _ = m.Health("b")
+	assert.Equal(t, bbb.HealthUp, h.State)
+	assert.Equal(t, 1, h.ConsecutiveFailures)
+	require.Error(t, h.LastError)
+
+	m.Check(ctx)
+	h, _ = m.Health("b")
+	assert.Equal(t, bbb.HealthDown, h.State)
+
+	// And comes back after a successful one.
+	bHealthy.Store(true)
+	m.Check(ctx)
+	h, _ = m.Health("b")
+	assert.Equal(t, bbb.HealthUp, h.State)
+	assert.Zero(t, h.ConsecutiveFailures)
+	assert.NoError(t, h.LastError)
+
+	require.NoError(t, m.Drain("a"))
+	m.Check(ctx)
+	assert.Equal(t, bbb.HealthDraining, m.All()[0].State)
+	require.NoError(t, m.Enable("a"))
+	assert.Equal(t, bbb.HealthUp, m.All()[0].State)
+	require.Error(t, m.Drain("unknown"))
+
+	assert.Equal(t, []string{"b:down", "b:up", "a:draining", "a:up"}, changes)
+}
+
+func TestHealthMonitor_CallbackSerialized(t *testing.T) {
+	var healthy atomic.Bool
+	servers := make([]*bbb.Server, 5)
+	for i := range servers {
+		servers[i] = healthServer(t, string(rune('a'+i)), &healthy)
+	}
+
+	var running atomic.Int32
+	var changes []string
+	m, err := bbb.NewHealthMonitor(servers,
+		bbb.WithFailureThreshold(1),
+		bbb.WithHealthCallback(func(h bbb.ServerHealth) {
+			assert.Equal(t, int32(1), running.Add(1), "callback called concurrently")
+			time.Sleep(5 * time.Millisecond)
+			changes = append(changes, h.Server.ID)
+			running.Add(-1)
+		}),
+	)
+	require.NoError(t, err)
+
+	m.Check(context.Background())
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, changes)
+}
+
+func TestHealthMonitor_Strategy(t *testing.T) {
+	var aHealthy, bHealthy atomic.Bool
+	aHealthy.Store(true)
+	a := healthServer(t, "a", &aHealthy)
+	b := healthServer(t, "b", &bHealthy)
+
+	m, err := bbb.NewHealthMonitor([]*bbb.Server{a, b}, bbb.WithFailureThreshold(1))
+	require.NoError(t, err)
+	m.Check(context.Background())
+
+	strategy := m.Strategy(bbb.RoundRobin())
+	assert.Equal(t, []string{"a", "a"}, pick(t, strategy, []*bbb.Server{a, b}, 2))
+
+	require.NoError(t, m.Drain("a"))
+	_, err = strategy.Pick(context.Background(), []*bbb.Server{a, b})
+	assert.ErrorIs(t, err, bbb.ErrNoServer)
+}
+
+func TestHealthMonitor_Run(t *testing.T) {
+	var healthy atomic.Bool
+	s := healthServer(t, "a", &healthy)
+
+	down := make(chan bbb.ServerHealth, 1)
+	m, err := bbb.NewHealthMonitor([]*bbb.Server{s},
+		bbb.WithFailureThreshold(1),
+		bbb.WithCheckTimeout(time.Second),
+		bbb.WithHealthCallback(func(h bbb.ServerHealth) { down <- h }),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- m.Run(ctx, 10*time.Millisecond) }()
+
+	select {
+	case h := <-down:
+		assert.Equal(t, bbb.HealthDown, h.State)
+	case <-time.After(time.Second):
+		t.Fatal("server was not reported down")
+	}
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestNewHealthMonitor_Validation(t *testing.T) {
+	var healthy atomic.Bool
+	s := healthServer(t, "a", &healthy)
+
+	_, err := bbb.NewHealthMonitor(nil)
+	require.Error(t, err)
+	_, err = bbb.NewHealthMonitor([]*bbb.Server{{ID: "a"}})
+	require.Error(t, err)
+	_, err = bbb.NewHealthMonitor([]*bbb.Server{{Client: s.Client}})
+	require.Error(t, err)
+	_, err = bbb.NewHealthMonitor([]*bbb.Server{s, {ID: "a", Client: s.Client}})
+	require.Error(t, err)
+	_, err = bbb.NewHealthMonitor([]*bbb.Server{s}, bbb.WithFailureThreshold(0))
+	require.Error(t, err)
+	_, err = bbb.NewHealthMonitor([]*bbb.Server{s}, bbb.WithCheckTimeout(0))
+	require.Error(t, err)
+
+	m, err := bbb.NewHealthMonitor([]*bbb.Server{s})
+	require.NoError(t, err)
+	require.Error(t, m.Run(context.Background(), 0))
+}
@@ -0,0 +1,71 @@
+/*
+Package bbb provides a Go client for the BigBlueButton API.
+
+This file contains the logging option and the helpers used to redact
+secrets from logged requests and responses.
+*/
+
+package bbb
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"regexp"
+)
+
+// redacted replaces sensitive values in logged requests and responses.
+const redacted = "REDACTED"
+
+// sensitiveParams lists the query parameters that are never logged verbatim.
+var sensitiveParams = []string{"checksum", "password", "attendeePW", "moderatorPW"}
+
+// sensitiveElements matches XML elements in response bodies that carry passwords.
+var sensitiveElements = regexp.MustCompile(`<(attendeePW|moderatorPW|password)>[^<]*</(attendeePW|moderatorPW|password)>`)
+
+// WithLogger sets the logger used to trace API calls. Requests and responses
+// are logged at debug level with checksums and passwords redacted.
+// By default the client does not log anything.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// logDebug logs a debug message if a logger is configured.
+func (c *Client) logDebug(ctx context.Context, msg string, args ...any) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.DebugContext(ctx, msg, args...)
+}
+
+// redactURL returns the URL with sensitive query parameters redacted.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	u.RawQuery = redactParams(u.Query()).Encode()
+	return u.String()
+}
+
+// redactParams returns a copy of params with sensitive values redacted.
+func redactParams(params url.Values) url.Values {
+	out := make(url.Values, len(params))
+	for k, v := range params {
+		out[k] = v
+	}
+	for _, k := range sensitiveParams {
+		if out.Has(k) {
+			out.Set(k, redacted)
+		}
+	}
+	return out
+}
+
+// redactBody returns the response body with password elements redacted.
+func redactBody(body []byte) string {
+	return sensitiveElements.ReplaceAllString(string(body), "<$1>"+redacted+"</$2>")
+}
@@ -0,0 +1,69 @@
+package bbb_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------- WithLogger --------------------
+
+func TestWithLogger_RedactsSecrets(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`
+			<response>
+				<returncode>SUCCESS</returncode>
+				<meetingID>test123</meetingID>
+				<attendeePW>secret-ap</attendeePW>
+				<moderatorPW>secret-mp</moderatorPW>
+			</response>`))
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := bbb.NewClient(ts.URL, "test-secret", bbb.WithLogger(logger))
+	require.NoError(t, err)
+
+	_, err = client.CreateMeeting(context.Background(), &requests.CreateMeetingRequest{
+		MeetingID:   "test123",
+		AttendeePW:  "secret-ap",
+		ModeratorPW: "secret-mp",
+	})
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "bbb api request")
+	assert.Contains(t, out, "bbb api response")
+	assert.Contains(t, out, "meetingID=test123")
+	assert.Contains(t, out, "REDACTED")
+	assert.NotContains(t, out, "secret-ap")
+	assert.NotContains(t, out, "secret-mp")
+	assert.NotRegexp(t, `checksum=[0-9a-f]{40}`, out)
+}
+
+func TestWithLogger_RespectsLevel(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<response><returncode>SUCCESS</returncode></response>`))
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	client, err := bbb.NewClient(ts.URL, "test-secret", bbb.WithLogger(logger))
+	require.NoError(t, err)
+
+	_, err = client.GetMeetings(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
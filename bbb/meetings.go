@@ -7,8 +7,11 @@ package bbb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
 	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
@@ -27,82 +30,244 @@ func (c *Client) CreateMeeting(ctx context.Context, req *requests.CreateMeetingR
 	if req.Name == "" {
 		req.Name = "Meeting " + req.MeetingID
 	}
-	if req.AttendeePW == "" {
-		req.AttendeePW = "ap"
+
+	params, err := createMeetingParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pre-uploaded presentations are sent in the body of a POST request
+	var payload *requestBody
+	if len(req.Presentations) > 0 {
+		if payload, err = presentationBody(req.Presentations); err != nil {
+			return nil, err
+		}
+	}
+
+	// Make the API call
+	var response responses.CreateMeetingResponse
+	if err := c.doRequestWithBody(ctx, "create", params, payload, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// createMeetingParams validates the request and converts it to create query parameters.
+func createMeetingParams(req *requests.CreateMeetingRequest) (url.Values, error) {
+	if boolValue(req.IsBreakout) && (req.ParentMeetingID == "" || req.Sequence <= 0) {
+		return nil, NewError(ErrMissingParam, "parentMeetingID and sequence are required for breakout rooms")
+	}
+	if req.GuestPolicy != "" && !req.GuestPolicy.Valid() {
+		return nil, NewError(ErrInvalidParam, "invalid guestPolicy: "+string(req.GuestPolicy))
 	}
-	if req.ModeratorPW == "" {
-		req.ModeratorPW = "mp"
+	if req.MeetingLayout != "" && !req.MeetingLayout.Valid() {
+		return nil, NewError(ErrInvalidParam, "invalid meetingLayout: "+string(req.MeetingLayout))
+	}
+	for _, features := range [][]requests.DisabledFeature{req.DisabledFeatures, req.DisabledFeaturesExclude} {
+		for _, f := range features {
+			if !f.Valid() {
+				return nil, NewError(ErrInvalidParam, "invalid disabled feature: "+string(f))
+			}
+		}
 	}
 
 	// Prepare parameters
 	params := url.Values{}
 	params.Set("name", req.Name)
 	params.Set("meetingID", req.MeetingID)
-	params.Set("attendeePW", req.AttendeePW)
-	params.Set("moderatorPW", req.ModeratorPW)
 
-	// Add optional parameters if provided
-	if req.Welcome != "" {
-		params.Set("welcome", req.Welcome)
+	// Add optional string parameters if provided; passwords are deprecated
+	// since BigBlueButton 2.4 in favor of role-based joins
+	setString(params, "attendeePW", req.AttendeePW)
+	setString(params, "moderatorPW", req.ModeratorPW)
+	setString(params, "welcome", req.Welcome)
+	setString(params, "dialNumber", req.DialNumber)
+	setString(params, "voiceBridge", req.VoiceBridge)
+	setString(params, "webVoice", req.WebVoice)
+	setString(params, "logoutURL", req.LogoutURL)
+	setString(params, "parentMeetingID", req.ParentMeetingID)
+	setString(params, "moderatorOnlyMessage", req.ModeratorOnlyMessage)
+	setString(params, "bannerText", req.BannerText)
+	setString(params, "bannerColor", req.BannerColor)
+	setString(params, "logo", req.Logo)
+	setString(params, "guestPolicy", string(req.GuestPolicy))
+	setString(params, "meetingLayout", string(req.MeetingLayout))
+	setString(params, "preUploadedPresentation", req.PreUploadedPresentation)
+	setString(params, "preUploadedPresentationName", req.PreUploadedPresentationName)
+	setString(params, "presentationUploadExternalUrl", req.PresentationUploadExternalURL)
+	setString(params, "presentationUploadExternalDescription", req.PresentationUploadExternalDescription)
+	setString(params, "pluginManifestsFetchUrl", req.PluginManifestsFetchURL)
+
+	// Add optional numeric parameters if set
+	setOptionalInt(params, "maxParticipants", req.MaxParticipants)
+	setOptionalInt(params, "duration", req.Duration)
+	setInt(params, "sequence", req.Sequence)
+	setOptionalInt(params, "meetingCameraCap", req.MeetingCameraCap)
+	setOptionalInt(params, "userCameraCap", req.UserCameraCap)
+	setOptionalInt(params, "endWhenNoModeratorDelayInMinutes", req.EndWhenNoModeratorDelayInMinutes)
+	setOptionalInt(params, "meetingExpireIfNoUserJoinedInMinutes", req.MeetingExpireIfNoUserJoinedInMinutes)
+	setOptionalInt(params, "meetingExpireWhenLastUserLeftInMinutes", req.MeetingExpireWhenLastUserLeftInMinutes)
+	setOptionalInt(params, "learningDashboardCleanupDelayInMinutes", req.LearningDashboardCleanupDelayInMinutes)
+	setOptionalInt(params, "maxNumPages", req.MaxNumPages)
+
+	// Add boolean flags if set, so unset ones keep the server defaults
+	setOptionalBool(params, "record", req.Record)
+	setOptionalBool(params, "autoStartRecording", req.AutoStartRecording)
+	setOptionalBool(params, "allowStartStopRecording", req.AllowStartStopRecording)
+	setOptionalBool(params, "recordFullDurationMedia", req.RecordFullDurationMedia)
+	setOptionalBool(params, "notifyRecordingIsOn", req.NotifyRecordingIsOn)
+	setOptionalBool(params, "webcamsOnlyForModerator", req.WebcamsOnlyForModerator)
+	setOptionalBool(params, "muteOnStart", req.MuteOnStart)
+	setOptionalBool(params, "allowModsToUnmuteUsers", req.AllowModsToUnmuteUsers)
+	setOptionalBool(params, "allowModsToEjectCameras", req.AllowModsToEjectCameras)
+	setOptionalBool(params, "allowRequestsWithoutSession", req.AllowRequestsWithoutSession)
+	setOptionalBool(params, "meetingKeepEvents", req.MeetingKeepEvents)
+	setOptionalBool(params, "endWhenNoModerator", req.EndWhenNoModerator)
+	setOptionalBool(params, "learningDashboardEnabled", req.LearningDashboardEnabled)
+	setOptionalBool(params, "preUploadedPresentationOverrideDefault", req.PreUploadedPresentationOverrideDefault)
+	setOptionalBool(params, "presentationConversionCacheEnabled", req.PresentationConversionCacheEnabled)
+
+	// Add lock settings
+	setOptionalBool(params, "lockSettingsDisableCam", req.LockSettingsDisableCam)
+	setOptionalBool(params, "lockSettingsDisableMic", req.LockSettingsDisableMic)
+	setOptionalBool(params, "lockSettingsDisablePrivateChat", req.LockSettingsDisablePrivateChat)
+	setOptionalBool(params, "lockSettingsDisablePublicChat", req.LockSettingsDisablePublicChat)
+	setOptionalBool(params, "lockSettingsDisableNotes", req.LockSettingsDisableNotes)
+	setOptionalBool(params, "lockSettingsHideUserList", req.LockSettingsHideUserList)
+	setOptionalBool(params, "lockSettingsHideViewersCursor", req.LockSettingsHideViewersCursor)
+	setOptionalBool(params, "lockSettingsHideViewersAnnotation", req.LockSettingsHideViewersAnnotation)
+	setOptionalBool(params, "lockSettingsLockedLayout", req.LockSettingsLockedLayout)
+	setOptionalBool(params, "lockSettingsLockOnJoin", req.LockSettingsLockOnJoin)
+	setOptionalBool(params, "lockSettingsLockOnJoinConfigurable", req.LockSettingsLockOnJoinConfigurable)
+
+	// Add breakout room settings
+	setOptionalBool(params, "isBreakout", req.IsBreakout)
+	setOptionalBool(params, "freeJoin", req.FreeJoin)
+	setOptionalBool(params, "breakoutRoomsEnabled", req.BreakoutRoomsEnabled)
+	setOptionalBool(params, "breakoutRoomsPrivateChatEnabled", req.BreakoutRoomsPrivateChatEnabled)
+	setOptionalBool(params, "breakoutRoomsRecord", req.BreakoutRoomsRecord)
+
+	// Add list parameters
+	setString(params, "disabledFeatures", joinFeatures(req.DisabledFeatures))
+	setString(params, "disabledFeaturesExclude", joinFeatures(req.DisabledFeaturesExclude))
+
+	// Add JSON encoded parameters
+	if len(req.Groups) > 0 {
+		groups, err := json.Marshal(req.Groups)
+		if err != nil {
+			return nil, fmt.Errorf("encoding groups: %w", err)
+		}
+		params.Set("groups", string(groups))
 	}
-	if req.DialNumber != "" {
-		params.Set("dialNumber", req.DialNumber)
+	if len(req.PluginManifests) > 0 {
+		manifests, err := json.Marshal(req.PluginManifests)
+		if err != nil {
+			return nil, fmt.Errorf("encoding pluginManifests: %w", err)
+		}
+		params.Set("pluginManifests", string(manifests))
 	}
-	if req.VoiceBridge != "" {
-		params.Set("voiceBridge", req.VoiceBridge)
+
+	// Add metadata
+	for k, v := range req.Meta {
+		params.Set("meta_"+k, v)
 	}
-	if req.WebVoice != "" {
-		params.Set("webVoice", req.WebVoice)
+
+	return params, nil
+}
+
+// JoinMeeting generates a join URL for a meeting.
+func (c *Client) JoinMeeting(ctx context.Context, req *requests.JoinMeetingRequest) (string, error) {
+	params, err := joinMeetingParams(req)
+	if err != nil {
+		return "", err
 	}
-	if req.LogoutURL != "" {
-		params.Set("logoutURL", req.LogoutURL)
+
+	// Generate the join URL
+	checksum := c.generateChecksum("join", params)
+	params.Set("checksum", checksum)
+
+	// Build the full URL
+	joinURL := c.baseURL + "join?" + params.Encode()
+
+	return joinURL, nil
+}
+
+// JoinMeetingSession calls the join API with redirect=false and returns the session
+// created for the user instead of a redirect URL. When the meeting has a guest lobby,
+// the response's GuestStatus tells whether the user is allowed, denied or waiting.
+func (c *Client) JoinMeetingSession(ctx context.Context, req *requests.JoinMeetingRequest) (*responses.JoinMeetingResponse, error) {
+	params, err := joinMeetingParams(req)
+	if err != nil {
+		return nil, err
 	}
-	if req.MaxParticipants > 0 {
-		params.Set("maxParticipants", strconv.Itoa(req.MaxParticipants))
+	params.Set("redirect", "false")
+
+	// Make the API call
+	var response responses.JoinMeetingResponse
+	if err := c.doRequest(ctx, "join", params, &response); err != nil {
+		return nil, err
 	}
 
-	// Add boolean flags
-	params.Set("record", boolToStr(req.Record))
-	params.Set("autoStartRecording", boolToStr(req.AutoStartRecording))
-	params.Set("allowStartStopRecording", boolToStr(req.AllowStartStopRecording))
-	params.Set("webcamsOnlyForModerator", boolToStr(req.WebcamsOnlyForModerator))
-	params.Set("muteOnStart", boolToStr(req.MuteOnStart))
+	return &response, nil
+}
 
-	// Add lock settings
-	params.Set("lockSettingsDisableCam", boolToStr(req.LockSettingsDisableCam))
-	params.Set("lockSettingsDisableMic", boolToStr(req.LockSettingsDisableMic))
-	params.Set("lockSettingsDisablePrivateChat", boolToStr(req.LockSettingsDisablePrivateChat))
-	params.Set("lockSettingsDisablePublicChat", boolToStr(req.LockSettingsDisablePublicChat))
-	params.Set("lockSettingsLockedLayout", boolToStr(req.LockSettingsLockedLayout))
-	params.Set("lockSettingsLockOnJoin", boolToStr(req.LockSettingsLockOnJoin))
-	params.Set("lockSettingsLockOnJoinConfigurable", boolToStr(req.LockSettingsLockOnJoinConfigurable))
+// GetJoinURL generates a new join URL for a user who already has a session (BigBlueButton 3.0+).
+func (c *Client) GetJoinURL(ctx context.Context, sessionToken string) (*responses.GetJoinURLResponse, error) {
+	if sessionToken == "" {
+		return nil, NewError(ErrMissingParam, "sessionToken is required")
+	}
 
-	// Add metadata
-	for k, v := range req.Meta {
-		params.Set("meta_"+k, v)
+	params := url.Values{
+		"sessionToken": {sessionToken},
 	}
 
 	// Make the API call
-	var response responses.CreateMeetingResponse
-	if err := c.doRequest(ctx, "create", params, &response); err != nil {
+	var response responses.GetJoinURLResponse
+	if err := c.doRequest(ctx, "getJoinUrl", params, &response); err != nil {
 		return nil, err
 	}
 
 	return &response, nil
 }
 
-// JoinMeeting generates a join URL for a meeting.
-func (c *Client) JoinMeeting(ctx context.Context, req *requests.JoinMeetingRequest) (string, error) {
+// Enter retrieves the details of a user session created by the join API.
+func (c *Client) Enter(ctx context.Context, sessionToken string) (*responses.EnterResponse, error) {
+	if sessionToken == "" {
+		return nil, NewError(ErrMissingParam, "sessionToken is required")
+	}
+
+	params := url.Values{
+		"sessionToken": {sessionToken},
+	}
+
+	// Make the API call
+	var response responses.EnterResponse
+	if err := c.doJSONRequest(ctx, "enter", params, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// joinMeetingParams validates the request and converts it to join query parameters.
+func joinMeetingParams(req *requests.JoinMeetingRequest) (url.Values, error) {
 	if req == nil {
-		return "", NewError(ErrInvalidParam, "request cannot be nil")
+		return nil, NewError(ErrInvalidParam, "request cannot be nil")
 	}
 
 	// Validate required parameters
 	if req.MeetingID == "" {
-		return "", NewError(ErrMissingParam, "meetingID is required")
+		return nil, NewError(ErrMissingParam, "meetingID is required")
+	}
+	if req.Password == "" && req.Role == "" {
+		return nil, NewError(ErrMissingParam, "password or role is required")
 	}
-	if req.Password == "" {
-		return "", NewError(ErrMissingParam, "password is required")
+	if req.Role != "" && !req.Role.Valid() {
+		return nil, NewError(ErrInvalidParam, "invalid role: "+string(req.Role))
+	}
+	if req.DefaultLayout != "" && !req.DefaultLayout.Valid() {
+		return nil, NewError(ErrInvalidParam, "invalid defaultLayout: "+string(req.DefaultLayout))
 	}
 	if req.FullName == "" {
 		req.FullName = "User"
@@ -111,33 +276,43 @@ func (c *Client) JoinMeeting(ctx context.Context, req *requests.JoinMeetingReque
 	// Prepare parameters
 	params := url.Values{}
 	params.Set("meetingID", req.MeetingID)
-	params.Set("password", req.Password)
 	params.Set("fullName", req.FullName)
 
+	// Add the credentials: a role, a password or both
+	setString(params, "role", string(req.Role))
+	setString(params, "password", req.Password)
+
 	// Add optional parameters
-	if req.UserID != "" {
-		params.Set("userID", req.UserID)
-	}
-	if req.CreateTime != "" {
-		params.Set("createTime", req.CreateTime)
+	setString(params, "userID", req.UserID)
+	setString(params, "createTime", req.CreateTime)
+	setString(params, "avatarURL", req.AvatarURL)
+	setString(params, "webcamBackgroundURL", req.WebcamBackgroundURL)
+	setString(params, "errorRedirectUrl", req.ErrorRedirectURL)
+	setString(params, "logoutURL", req.LogoutURL)
+	setString(params, "configToken", req.ConfigToken)
+	setString(params, "defaultLayout", string(req.DefaultLayout))
+	setOptionalBool(params, "guest", req.Guest)
+	setOptionalBool(params, "bot", req.Bot)
+	setOptionalBool(params, "excludeFromDashboard", req.ExcludeFromDashboard)
+	setOptionalBool(params, "redirect", req.Redirect)
+
+	// Add client settings, letting explicit user data take precedence
+	if req.ClientSettings != nil {
+		for k, v := range req.ClientSettings.UserData() {
+			params.Set("userdata-"+k, v)
+		}
 	}
 
 	// Add user data
 	for k, v := range req.UserData {
-		params.Set("userdata_"+k, v)
+		params.Set("userdata-"+k, v)
 	}
 
-	// Generate the join URL
-	checksum := c.generateChecksum("join", params)
-	params.Set("checksum", checksum)
-
-	// Build the full URL
-	joinURL := c.baseURL + "join?" + params.Encode()
-
-	return joinURL, nil
+	return params, nil
 }
 
 // EndMeeting ends a running meeting.
+// The password is optional since BigBlueButton 2.4 and only sent when set.
 func (c *Client) EndMeeting(ctx context.Context, req *requests.EndMeetingRequest) (*responses.EndMeetingResponse, error) {
 	if req == nil {
 		return nil, NewError(ErrInvalidParam, "request cannot be nil")
@@ -147,14 +322,11 @@ func (c *Client) EndMeeting(ctx context.Context, req *requests.EndMeetingRequest
 	if req.MeetingID == "" {
 		return nil, NewError(ErrMissingParam, "meetingID is required")
 	}
-	if req.Password == "" {
-		return nil, NewError(ErrMissingParam, "password is required")
-	}
 
 	// Prepare parameters
 	params := url.Values{}
 	params.Set("meetingID", req.MeetingID)
-	params.Set("password", req.Password)
+	setString(params, "password", req.Password)
 
 	// Make the API call
 	var response responses.EndMeetingResponse
@@ -166,18 +338,16 @@ func (c *Client) EndMeeting(ctx context.Context, req *requests.EndMeetingRequest
 }
 
 // GetMeetingInfo retrieves information about a specific meeting.
+// The password is optional since BigBlueButton 2.4; pass an empty string to omit it.
 func (c *Client) GetMeetingInfo(ctx context.Context, meetingID, password string) (*responses.GetMeetingInfoResponse, error) {
 	if meetingID == "" {
 		return nil, NewError(ErrMissingParam, "meetingID is required")
 	}
-	if password == "" {
-		return nil, NewError(ErrMissingParam, "password is required")
-	}
 
 	// Prepare parameters
 	params := url.Values{}
 	params.Set("meetingID", meetingID)
-	params.Set("password", password)
+	setString(params, "password", password)
 
 	// Make the API call
 	var response responses.GetMeetingInfoResponse
@@ -214,8 +384,8 @@ func (c *Client) IsMeetingRunning(ctx context.Context, meetingID string) (bool,
 
 	// Response structure for isMeetingRunning
 	type isMeetingRunningResponse struct {
-		ReturnCode string `xml:"returncode"`
-		Running    bool   `xml:"running"`
+		responses.BaseResponseImpl
+		Running bool `xml:"running"`
 	}
 
 	// Make the API call
@@ -231,3 +401,45 @@ func (c *Client) IsMeetingRunning(ctx context.Context, meetingID string) (bool,
 func boolToStr(b bool) string {
 	return strconv.FormatBool(b)
 }
+
+// setString sets a parameter if the value is not empty.
+func setString(params url.Values, key, value string) {
+	if value != "" {
+		params.Set(key, value)
+	}
+}
+
+// setInt sets a parameter if the value is positive.
+func setInt(params url.Values, key string, value int) {
+	if value > 0 {
+		params.Set(key, strconv.Itoa(value))
+	}
+}
+
+// setOptionalInt sets a parameter if the value is not nil.
+func setOptionalInt(params url.Values, key string, value *int) {
+	if value != nil {
+		params.Set(key, strconv.Itoa(*value))
+	}
+}
+
+// setOptionalBool sets a parameter if the flag is not nil.
+func setOptionalBool(params url.Values, key string, value *bool) {
+	if value != nil {
+		params.Set(key, boolToStr(*value))
+	}
+}
+
+// boolValue returns the value of an optional flag, treating nil as false.
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
+
+// joinFeatures converts a list of features to a comma-separated string.
+func joinFeatures(features []requests.DisabledFeature) string {
+	names := make([]string, len(features))
+	for i, f := range features {
+		names[i] = string(f)
+	}
+	return strings.Join(names, ",")
+}
@@ -2,11 +2,17 @@ package bbb_test
 
 import (
 	"context"
+	"io"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/amirazad1/bigbluebutton-api-go/bbb"
 	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -30,8 +36,10 @@ func TestCreateMeeting_Success(t *testing.T) {
 	})
 
 	resp, err := client.CreateMeeting(context.Background(), &requests.CreateMeetingRequest{
-		MeetingID: "test123",
-		Name:      "Test Meeting",
+		MeetingID:   "test123",
+		Name:        "Test Meeting",
+		AttendeePW:  "ap",
+		ModeratorPW: "mp",
 	})
 
 	require.NoError(t, err)
@@ -43,8 +51,10 @@ func TestCreateMeeting_Success(t *testing.T) {
 func TestCreateMeeting_DefaultValues(t *testing.T) {
 	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "Meeting test123", r.URL.Query().Get("name"))
-		assert.Equal(t, "ap", r.URL.Query().Get("attendeePW"))
-		assert.Equal(t, "mp", r.URL.Query().Get("moderatorPW"))
+
+		// Passwords are no longer invented; the server generates them if it needs them
+		assert.False(t, r.URL.Query().Has("attendeePW"))
+		assert.False(t, r.URL.Query().Has("moderatorPW"))
 
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(`<response><returncode>SUCCESS</returncode></response>`))
@@ -57,6 +67,107 @@ func TestCreateMeeting_DefaultValues(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCreateMeeting_ExtendedParameters(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "90", q.Get("duration"))
+		assert.Equal(t, "ASK_MODERATOR", q.Get("guestPolicy"))
+		assert.Equal(t, "VIDEO_FOCUS", q.Get("meetingLayout"))
+		assert.Equal(t, "chat,polls", q.Get("disabledFeatures"))
+		assert.Equal(t, "Exam in progress", q.Get("bannerText"))
+		assert.Equal(t, "#FF0000", q.Get("bannerColor"))
+		assert.Equal(t, "true", q.Get("endWhenNoModerator"))
+		assert.Equal(t, "5", q.Get("endWhenNoModeratorDelayInMinutes"))
+		assert.Equal(t, "3", q.Get("userCameraCap"))
+		assert.Equal(t, "true", q.Get("allowModsToUnmuteUsers"))
+		assert.Equal(t, `[{"id":"1","name":"Group A","roster":["user-1","user-2"]}]`, q.Get("groups"))
+		assert.False(t, q.Has("isBreakout"))
+		assert.False(t, q.Has("meetingCameraCap"))
+
+		w.Write([]byte(`<response><returncode>SUCCESS</returncode></response>`))
+	})
+
+	_, err := client.CreateMeeting(context.Background(), &requests.CreateMeetingRequest{
+		MeetingID:                        "test123",
+		Duration:                         requests.Int(90),
+		GuestPolicy:                      requests.GuestPolicyAskModerator,
+		MeetingLayout:                    requests.LayoutVideoFocus,
+		DisabledFeatures:                 []requests.DisabledFeature{requests.FeatureChat, requests.FeaturePolls},
+		BannerText:                       "Exam in progress",
+		BannerColor:                      "#FF0000",
+		EndWhenNoModerator:               requests.Bool(true),
+		EndWhenNoModeratorDelayInMinutes: requests.Int(5),
+		UserCameraCap:                    requests.Int(3),
+		AllowModsToUnmuteUsers:           requests.Bool(true),
+		Groups: []requests.BreakoutGroup{
+			{ID: "1", Name: "Group A", Roster: []string{"user-1", "user-2"}},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestCreateMeeting_OptionalParameters(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "false", q.Get("record"))
+		assert.Equal(t, "0", q.Get("maxParticipants"))
+		assert.Equal(t, "true", q.Get("lockSettingsDisableCam"))
+
+		// Unset fields must not override the server defaults
+		assert.False(t, q.Has("muteOnStart"))
+		assert.False(t, q.Has("autoStartRecording"))
+		assert.False(t, q.Has("lockSettingsDisableMic"))
+		assert.False(t, q.Has("duration"))
+
+		w.Write([]byte(`<response><returncode>SUCCESS</returncode></response>`))
+	})
+
+	_, err := client.CreateMeeting(context.Background(), &requests.CreateMeetingRequest{
+		MeetingID:              "test123",
+		Record:                 requests.Bool(false),
+		MaxParticipants:        requests.Int(0),
+		LockSettingsDisableCam: requests.Bool(true),
+	})
+	require.NoError(t, err)
+}
+
+func TestCreateMeeting_Presentations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.pdf")
+	require.NoError(t, os.WriteFile(path, []byte("%PDF-1.4"), 0o600))
+	fromFile, err := requests.DocumentFromFile(path)
+	require.NoError(t, err)
+
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/create", r.URL.Path)
+		assert.Equal(t, "application/xml", r.Header.Get("Content-Type"))
+		assert.Equal(t, "test123", r.URL.Query().Get("meetingID"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `<modules><module name="presentation">`+
+			`<document url="https://example.com/slides.pdf" filename="slides.pdf" current="true" downloadable="true"></document>`+
+			`<document name="agenda.txt" removable="false">SGVsbG8=</document>`+
+			`<document name="notes.pdf">JVBERi0xLjQ=</document>`+
+			`</module></modules>`, string(body))
+
+		w.Write([]byte(`<response><returncode>SUCCESS</returncode></response>`))
+	})
+
+	slides := requests.DocumentFromURL("https://example.com/slides.pdf", "slides.pdf")
+	slides.Current = requests.Bool(true)
+	slides.Downloadable = requests.Bool(true)
+	agenda, err := requests.DocumentFromReader("agenda.txt", strings.NewReader("Hello"))
+	require.NoError(t, err)
+	agenda.Removable = requests.Bool(false)
+
+	_, err = client.CreateMeeting(context.Background(), &requests.CreateMeetingRequest{
+		MeetingID:     "test123",
+		Presentations: []requests.Document{slides, agenda, fromFile},
+	})
+	require.NoError(t, err)
+}
+
 func TestCreateMeeting_ValidationErrors(t *testing.T) {
 	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {})
 
@@ -66,6 +177,12 @@ func TestCreateMeeting_ValidationErrors(t *testing.T) {
 	}{
 		{"nil request", nil},
 		{"missing meetingID", &requests.CreateMeetingRequest{}},
+		{"breakout without parent", &requests.CreateMeetingRequest{MeetingID: "test123", IsBreakout: requests.Bool(true)}},
+		{"invalid guest policy", &requests.CreateMeetingRequest{MeetingID: "test123", GuestPolicy: "SOMETIMES"}},
+		{"invalid layout", &requests.CreateMeetingRequest{MeetingID: "test123", MeetingLayout: "GRID"}},
+		{"invalid disabled feature", &requests.CreateMeetingRequest{MeetingID: "test123", DisabledFeatures: []requests.DisabledFeature{"teleport"}}},
+		{"empty document", &requests.CreateMeetingRequest{MeetingID: "test123", Presentations: []requests.Document{{}}}},
+		{"inline document without filename", &requests.CreateMeetingRequest{MeetingID: "test123", Presentations: []requests.Document{{Content: []byte("x")}}}},
 	}
 
 	for _, tt := range tests {
@@ -97,6 +214,70 @@ func TestJoinMeeting_SuccessAndChecksum(t *testing.T) {
 	assert.Contains(t, joinURL, "checksum=")
 }
 
+func TestJoinMeeting_RoleWithoutPassword(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	joinURL, err := client.JoinMeeting(context.Background(), &requests.JoinMeetingRequest{
+		FullName:  "Jane Doe",
+		MeetingID: "test123",
+		Role:      requests.RoleViewer,
+	})
+	require.NoError(t, err)
+
+	u, err := url.Parse(joinURL)
+	require.NoError(t, err)
+	assert.Equal(t, "VIEWER", u.Query().Get("role"))
+	assert.False(t, u.Query().Has("password"))
+}
+
+func TestJoinMeeting_ExtendedParameters(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	joinURL, err := client.JoinMeeting(context.Background(), &requests.JoinMeetingRequest{
+		FullName:             "Jane Doe",
+		MeetingID:            "test123",
+		Password:             "mp",
+		Role:                 requests.RoleModerator,
+		AvatarURL:            "https://example.com/avatar.png",
+		Guest:                requests.Bool(false),
+		ExcludeFromDashboard: requests.Bool(true),
+		ErrorRedirectURL:     "https://example.com/error",
+		DefaultLayout:        requests.LayoutPresentationFocus,
+		ClientSettings: &requests.ClientSettings{
+			AutoJoinAudio:  requests.Bool(true),
+			ListenOnlyMode: requests.Bool(false),
+			SkipCheckAudio: requests.Bool(true),
+			PresenterTools: []string{"pencil", "hand"},
+			ClientTitle:    "Math 101",
+		},
+		UserData: map[string]string{
+			"course":              "math-101",
+			"bbb_auto_join_audio": "false",
+		},
+	})
+	require.NoError(t, err)
+
+	u, err := url.Parse(joinURL)
+	require.NoError(t, err)
+	q := u.Query()
+	assert.Equal(t, "MODERATOR", q.Get("role"))
+	assert.Equal(t, "https://example.com/avatar.png", q.Get("avatarURL"))
+	assert.Equal(t, "false", q.Get("guest"))
+	assert.Equal(t, "true", q.Get("excludeFromDashboard"))
+	assert.Equal(t, "https://example.com/error", q.Get("errorRedirectUrl"))
+	assert.Equal(t, "PRESENTATION_FOCUS", q.Get("defaultLayout"))
+	assert.False(t, q.Has("bot"))
+	assert.False(t, q.Has("redirect"))
+
+	// Explicit user data overrides client settings
+	assert.Equal(t, "false", q.Get("userdata-bbb_auto_join_audio"))
+	assert.Equal(t, "false", q.Get("userdata-bbb_listen_only_mode"))
+	assert.Equal(t, "true", q.Get("userdata-bbb_skip_check_audio"))
+	assert.Equal(t, "[pencil,hand]", q.Get("userdata-bbb_presenter_tools"))
+	assert.Equal(t, "Math 101", q.Get("userdata-bbb_client_title"))
+	assert.Equal(t, "math-101", q.Get("userdata-course"))
+}
+
 func TestJoinMeeting_ValidationErrors(t *testing.T) {
 	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {})
 
@@ -106,7 +287,9 @@ func TestJoinMeeting_ValidationErrors(t *testing.T) {
 	}{
 		{"nil request", nil},
 		{"missing meetingID", &requests.JoinMeetingRequest{Password: "mp"}},
-		{"missing password", &requests.JoinMeetingRequest{MeetingID: "test123"}},
+		{"missing password and role", &requests.JoinMeetingRequest{MeetingID: "test123"}},
+		{"invalid role", &requests.JoinMeetingRequest{MeetingID: "test123", Password: "mp", Role: "ADMIN"}},
+		{"invalid layout", &requests.JoinMeetingRequest{MeetingID: "test123", Password: "mp", DefaultLayout: "GRID"}},
 	}
 
 	for _, tt := range tests {
@@ -117,6 +300,155 @@ func TestJoinMeeting_ValidationErrors(t *testing.T) {
 	}
 }
 
+func TestJoinMeetingSession_Success(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/join", r.URL.Path)
+		assert.Equal(t, "false", r.URL.Query().Get("redirect"))
+		assert.Equal(t, "test123", r.URL.Query().Get("meetingID"))
+		assert.NotEmpty(t, r.URL.Query().Get("checksum"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`
+			<response>
+				<returncode>SUCCESS</returncode>
+				<messageKey>successfullyJoined</messageKey>
+				<message>You have joined successfully.</message>
+				<meeting_id>183f0bf3a0982a127bdb8161e0c44eb696b3e75c-1531240585189</meeting_id>
+				<user_id>w_euxnssffnsbs</user_id>
+				<auth_token>14mm5y3eurjw</auth_token>
+				<session_token>ai1wqj8wb6s7rnk0</session_token>
+				<guestStatus>WAIT</guestStatus>
+				<url>https://example.com/html5client/guestWait?sessionToken=ai1wqj8wb6s7rnk0</url>
+			</response>`))
+	})
+
+	resp, err := client.JoinMeetingSession(context.Background(), &requests.JoinMeetingRequest{
+		FullName:  "Guest",
+		MeetingID: "test123",
+		Password:  "ap",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "successfullyJoined", resp.MessageKey)
+	assert.Equal(t, "w_euxnssffnsbs", resp.UserID)
+	assert.Equal(t, "14mm5y3eurjw", resp.AuthToken)
+	assert.Equal(t, "ai1wqj8wb6s7rnk0", resp.SessionToken)
+	assert.True(t, resp.IsWaitingForApproval())
+	assert.Contains(t, resp.URL, "guestWait")
+}
+
+func TestGetJoinURL_Success(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/getJoinUrl", r.URL.Path)
+		assert.Equal(t, "ai1wqj8wb6s7rnk0", r.URL.Query().Get("sessionToken"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`
+			<response>
+				<returncode>SUCCESS</returncode>
+				<messageKey>joinUrlGenerated</messageKey>
+				<url>https://example.com/html5client/join?sessionToken=q2ho6erh4ewbgsbe</url>
+			</response>`))
+	})
+
+	resp, err := client.GetJoinURL(context.Background(), "ai1wqj8wb6s7rnk0")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/html5client/join?sessionToken=q2ho6erh4ewbgsbe", resp.URL)
+
+	_, err = client.GetJoinURL(context.Background(), "")
+	require.Error(t, err)
+}
+
+func TestEnter_Success(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/enter", r.URL.Path)
+		assert.Equal(t, "ai1wqj8wb6s7rnk0", r.URL.Query().Get("sessionToken"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"response": {
+				"returncode": "SUCCESS",
+				"fullname": "Jane Doe",
+				"confname": "Test Meeting",
+				"meetingID": "183f0bf3a0982a127bdb8161e0c44eb696b3e75c-1531240585189",
+				"externMeetingID": "test123",
+				"externUserID": "user-1",
+				"internalUserID": "w_euxnssffnsbs",
+				"authToken": "14mm5y3eurjw",
+				"role": "MODERATOR",
+				"guest": "false",
+				"guestStatus": "ALLOW",
+				"conference": "183f0bf3a0982a127bdb8161e0c44eb696b3e75c-1531240585189",
+				"room": "183f0bf3a0982a127bdb8161e0c44eb696b3e75c-1531240585189",
+				"voicebridge": "70066",
+				"dialnumber": "613-555-1234",
+				"webvoiceconf": "70066",
+				"mode": "LIVE",
+				"record": "false",
+				"isBreakout": false,
+				"logoutTimer": 0,
+				"allowStartStopRecording": true,
+				"recordFullDurationMedia": false,
+				"welcome": "Welcome to <b>Test Meeting</b>!",
+				"customLogoURL": "",
+				"customCopyright": "",
+				"muteOnStart": false,
+				"allowModsToUnmuteUsers": false,
+				"logoutUrl": "https://example.com",
+				"defaultLayout": "CUSTOM_LAYOUT",
+				"avatarURL": "https://example.com/avatar.png",
+				"webcamBackgroundURL": "",
+				"breakoutRooms": {
+					"record": true,
+					"privateChatEnabled": true,
+					"captureNotes": false,
+					"captureSlides": false,
+					"captureNotesFilename": "%%CONFNAME%%",
+					"captureSlidesFilename": "%%CONFNAME%%"
+				},
+				"customdata": [
+					{"bbb_auto_join_audio": "true"}
+				],
+				"metadata": [
+					{"bbb-origin": "Moodle"},
+					{"course": "math-101"}
+				]
+			}
+		}`))
+	})
+
+	resp, err := client.Enter(context.Background(), "ai1wqj8wb6s7rnk0")
+	require.NoError(t, err)
+	assert.Equal(t, "SUCCESS", resp.ReturnCode)
+	assert.Equal(t, "Jane Doe", resp.FullName)
+	assert.Equal(t, "test123", resp.ExternalMeetingID)
+	assert.Equal(t, "MODERATOR", resp.Role)
+	assert.Equal(t, "ALLOW", resp.GuestStatus)
+	assert.Equal(t, responses.Metadata{"bbb-origin": "Moodle", "course": "math-101"}, resp.Metadata)
+	assert.Equal(t, responses.CustomData{"bbb_auto_join_audio": "true"}, resp.CustomData)
+}
+
+func TestEnter_EmptyMetadata(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"returncode":"SUCCESS","fullname":"Jane Doe","customdata":[],"metadata":[]}}`))
+	})
+
+	resp, err := client.Enter(context.Background(), "ai1wqj8wb6s7rnk0")
+	require.NoError(t, err)
+	assert.Empty(t, resp.Metadata)
+	assert.Empty(t, resp.CustomData)
+}
+
+func TestEnter_Failed(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"returncode":"FAILED","message":"Could not find conference.","messageKey":"notFound"}}`))
+	})
+
+	_, err := client.Enter(context.Background(), "expired")
+	require.Error(t, err)
+	assert.True(t, bbb.IsError(err, bbb.ErrNotFound))
+}
+
 // -------------------- EndMeeting --------------------
 
 func TestEndMeeting_Success(t *testing.T) {
@@ -143,6 +475,18 @@ func TestEndMeeting_Success(t *testing.T) {
 	assert.Equal(t, "sentEndMeetingRequest", resp.MessageKey)
 }
 
+func TestEndMeeting_WithoutPassword(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test123", r.URL.Query().Get("meetingID"))
+		assert.False(t, r.URL.Query().Has("password"))
+
+		w.Write([]byte(`<response><returncode>SUCCESS</returncode><messageKey>sentEndMeetingRequest</messageKey></response>`))
+	})
+
+	_, err := client.EndMeeting(context.Background(), &requests.EndMeetingRequest{MeetingID: "test123"})
+	require.NoError(t, err)
+}
+
 func TestEndMeeting_ValidationErrors(t *testing.T) {
 	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {})
 
@@ -152,7 +496,6 @@ func TestEndMeeting_ValidationErrors(t *testing.T) {
 	}{
 		{"nil request", nil},
 		{"missing meetingID", &requests.EndMeetingRequest{Password: "mp"}},
-		{"missing password", &requests.EndMeetingRequest{MeetingID: "test123"}},
 	}
 
 	for _, tt := range tests {
@@ -163,6 +506,116 @@ func TestEndMeeting_ValidationErrors(t *testing.T) {
 	}
 }
 
+// -------------------- GetMeetingInfo --------------------
+
+func TestGetMeetingInfo_Attendees(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/getMeetingInfo", r.URL.Path)
+		assert.Equal(t, "test123", r.URL.Query().Get("meetingID"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`
+			<response>
+				<returncode>SUCCESS</returncode>
+				<meetingName>Test Meeting</meetingName>
+				<meetingID>test123</meetingID>
+				<running>true</running>
+				<participantCount>2</participantCount>
+				<moderatorCount>1</moderatorCount>
+				<attendees>
+					<attendee>
+						<userID>w_abc123</userID>
+						<fullName>Jane Doe</fullName>
+						<role>MODERATOR</role>
+						<isPresenter>true</isPresenter>
+						<isListeningOnly>false</isListeningOnly>
+						<hasJoinedVoice>true</hasJoinedVoice>
+						<hasVideo>true</hasVideo>
+						<clientType>HTML5</clientType>
+						<customdata>
+							<course>math-101</course>
+							<bbb_auto_join_audio>true</bbb_auto_join_audio>
+						</customdata>
+					</attendee>
+					<attendee>
+						<userID>w_def456</userID>
+						<fullName>John Doe</fullName>
+						<role>VIEWER</role>
+						<isPresenter>false</isPresenter>
+						<isListeningOnly>true</isListeningOnly>
+						<hasJoinedVoice>false</hasJoinedVoice>
+						<hasVideo>false</hasVideo>
+						<clientType>HTML5</clientType>
+						<customdata/>
+					</attendee>
+				</attendees>
+			</response>`))
+	})
+
+	resp, err := client.GetMeetingInfo(context.Background(), "test123", "mp")
+	require.NoError(t, err)
+
+	require.Len(t, resp.Attendees, 2)
+	moderator := resp.Attendees[0]
+	assert.Equal(t, "w_abc123", moderator.UserID)
+	assert.Equal(t, "Jane Doe", moderator.FullName)
+	assert.True(t, moderator.IsModerator())
+	assert.True(t, moderator.IsPresenter)
+	assert.True(t, moderator.HasJoinedVoice)
+	assert.True(t, moderator.HasVideo)
+	assert.Equal(t, "HTML5", moderator.ClientType)
+	assert.Equal(t, "math-101", moderator.CustomData["course"])
+	assert.Equal(t, "true", moderator.CustomData["bbb_auto_join_audio"])
+
+	viewer := resp.Attendees[1]
+	assert.False(t, viewer.IsModerator())
+	assert.True(t, viewer.IsListeningOnly)
+	assert.Empty(t, viewer.CustomData)
+}
+
+func TestGetMeetingInfo_Metadata(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.False(t, r.URL.Query().Has("password"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`
+			<response>
+				<returncode>SUCCESS</returncode>
+				<meetingName>Demo Meeting</meetingName>
+				<meetingID>random-1234567</meetingID>
+				<internalMeetingID>ebe9a3d2eee9c3e8a7b2c7dc8ac8e4d6e0ef4c9e-1531240585189</internalMeetingID>
+				<createTime>1531240585189</createTime>
+				<createDate>Tue Jul 10 16:36:25 UTC 2018</createDate>
+				<voiceBridge>70066</voiceBridge>
+				<dialNumber>613-555-1234</dialNumber>
+				<running>true</running>
+				<recording>false</recording>
+				<hasBeenForciblyEnded>false</hasBeenForciblyEnded>
+				<startTime>1531240585239</startTime>
+				<endTime>0</endTime>
+				<participantCount>0</participantCount>
+				<moderatorCount>0</moderatorCount>
+				<metadata>
+					<bbb-origin-server-name>my-lms.example.com</bbb-origin-server-name>
+					<bbb-origin>Moodle</bbb-origin>
+					<course>Math &amp; Physics</course>
+				</metadata>
+				<attendees></attendees>
+			</response>`))
+	})
+
+	resp, err := client.GetMeetingInfo(context.Background(), "random-1234567", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Demo Meeting", resp.MeetingName)
+	assert.Equal(t, responses.Metadata{
+		"bbb-origin-server-name": "my-lms.example.com",
+		"bbb-origin":             "Moodle",
+		"course":                 "Math & Physics",
+	}, resp.Metadata)
+	assert.Equal(t, 0, resp.ModeratorCount)
+}
+
 // -------------------- GetMeetings --------------------
 
 func TestGetMeetings_Success(t *testing.T) {
@@ -178,6 +631,16 @@ func TestGetMeetings_Success(t *testing.T) {
 						<meetingID>test123</meetingID>
 						<meetingName>Test Meeting</meetingName>
 						<running>true</running>
+						<metadata>
+							<course>math-101</course>
+						</metadata>
+						<attendees>
+							<attendee>
+								<userID>w_abc123</userID>
+								<fullName>Jane Doe</fullName>
+								<role>MODERATOR</role>
+							</attendee>
+						</attendees>
 					</meeting>
 				</meetings>
 			</response>`))
@@ -189,6 +652,9 @@ func TestGetMeetings_Success(t *testing.T) {
 	assert.Equal(t, "SUCCESS", resp.ReturnCode)
 	require.Len(t, resp.Meetings, 1)
 	assert.True(t, resp.Meetings[0].Running)
+	assert.Equal(t, "math-101", resp.Meetings[0].Metadata["course"])
+	require.Len(t, resp.Meetings[0].Attendees, 1)
+	assert.Equal(t, "Jane Doe", resp.Meetings[0].Attendees[0].FullName)
 }
 
 // -------------------- IsMeetingRunning --------------------
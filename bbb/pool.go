@@ -0,0 +1,340 @@
+/*
+Package bbb provides a Go client for the BigBlueButton API.
+
+This file contains the Pool, which spreads meetings over several
+BigBlueButton servers and routes calls to the server hosting a meeting.
+*/
+
+package bbb
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
+)
+
+// ErrNoServer is returned when no server of a pool can host a new meeting.
+var ErrNoServer = errors.New("bbb: no server available")
+
+// Server is a BigBlueButton server of a Pool.
+type Server struct {
+	ID     string  // Unique within the pool
+	Client *Client // Client bound to the server
+	Weight int     // Relative share of meetings for the Weighted strategy; values below 1 count as 1
+}
+
+// Pool holds the clients of several BigBlueButton servers. New meetings are
+// placed on a server chosen by the pool's Strategy, and calls about an existing
+// meeting are sent to the server hosting it, as recorded in the pool's MeetingStore.
+type Pool struct {
+	servers  []*Server
+	byID     map[string]*Server
+	strategy Strategy
+	store    MeetingStore
+	creating keyedMutex
+}
+
+// PoolOption configures a Pool.
+type PoolOption func(*Pool) error
+
+// WithStrategy sets how the pool chooses the server of a new meeting.
+// The default is LeastMeetings.
+func WithStrategy(s Strategy) PoolOption {
+	return func(p *Pool) error {
+		if s == nil {
+			return NewError(ErrInvalidParam, "strategy cannot be nil")
+		}
+		p.strategy = s
+		return nil
+	}
+}
+
+// WithMeetingStore sets where the pool records the server hosting each meeting.
+// The default is a MemoryStore.
+func WithMeetingStore(store MeetingStore) PoolOption {
+	return func(p *Pool) error {
+		if store == nil {
+			return NewError(ErrInvalidParam, "meeting store cannot be nil")
+		}
+		p.store = store
+		return nil
+	}
+}
+
+// NewPool creates a pool of the given servers.
+func NewPool(servers []*Server, options ...PoolOption) (*Pool, error) {
+	if len(servers) == 0 {
+		return nil, NewError(ErrMissingParam, "at least one server is required")
+	}
+
+	byID := make(map[string]*Server, len(servers))
+	for _, s := range servers {
+		if s == nil || s.Client == nil {
+			return nil, NewError(ErrInvalidParam, "server client cannot be nil")
+		}
+		if s.ID == "" {
+			return nil, NewError(ErrMissingParam, "server ID is required")
+		}
+		if byID[s.ID] != nil {
+			return nil, NewError(ErrInvalidParam, "duplicate server ID: "+s.ID)
+		}
+		byID[s.ID] = s
+	}
+
+	p := &Pool{
+		servers:  append([]*Server(nil), servers...),
+		byID:     byID,
+		strategy: LeastMeetings(),
+		store:    NewMemoryStore(),
+	}
+
+	for _, option := range options {
+		if err := option(p); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// Servers returns the servers of the pool.
+func (p *Pool) Servers() []*Server {
+	return append([]*Server(nil), p.servers...)
+}
+
+// Server returns the server with the given ID, or nil.
+func (p *Pool) Server(id string) *Server {
+	return p.byID[id]
+}
+
+// ServerFor returns the server hosting a meeting. Meetings missing from the
+// pool's store, e.g. created by another application, are looked up on every
+// server and recorded. An error matching ErrAPINotFound is returned when no
+// server knows the meeting.
+func (p *Pool) ServerFor(ctx context.Context, meetingID string) (*Server, error) {
+	if meetingID == "" {
+		return nil, NewError(ErrMissingParam, "meetingID is required")
+	}
+
+	if s, err := p.stored(ctx, meetingID); s != nil || err != nil {
+		return s, err
+	}
+
+	s, err := p.locate(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.Assign(ctx, meetingID, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Place returns the server on which a meeting should be created: the server
+// already hosting it, or else the one chosen by the pool's strategy. Call
+// Assign once the meeting has been created there.
+func (p *Pool) Place(ctx context.Context, meetingID string) (*Server, error) {
+	if meetingID == "" {
+		return nil, NewError(ErrMissingParam, "meetingID is required")
+	}
+
+	if s, err := p.stored(ctx, meetingID); s != nil || err != nil {
+		return s, err
+	}
+	return p.strategy.Pick(ctx, p.Servers())
+}
+
+// Assign records the server hosting a meeting.
+func (p *Pool) Assign(ctx context.Context, meetingID string, s *Server) error {
+	return p.store.Put(ctx, meetingID, s.ID)
+}
+
+// Forget removes the record of a meeting, e.g. after it ended.
+func (p *Pool) Forget(ctx context.Context, meetingID string) error {
+	return p.store.Delete(ctx, meetingID)
+}
+
+// stored returns the server recorded for a meeting, or nil if there is none
+// or the server is no longer part of the pool.
+func (p *Pool) stored(ctx context.Context, meetingID string) (*Server, error) {
+	id, ok, err := p.store.Get(ctx, meetingID)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return p.byID[id], nil
+}
+
+// locate asks every server for the meeting and returns the first one hosting it.
+func (p *Pool) locate(ctx context.Context, meetingID string) (*Server, error) {
+	found := make([]bool, len(p.servers))
+	errs := make([]error, len(p.servers))
+
+	var wg sync.WaitGroup
+	for i, s := range p.servers {
+		wg.Add(1)
+		go func(i int, s *Server) {
+			defer wg.Done()
+			_, err := s.Client.GetMeetingInfo(ctx, meetingID, "")
+			found[i] = err == nil
+			if err != nil && !errors.Is(err, ErrAPINotFound) {
+				errs[i] = err
+			}
+		}(i, s)
+	}
+	wg.Wait()
+
+	for i, s := range p.servers {
+		if found[i] {
+			return s, nil
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return nil, &APIError{Action: "getMeetingInfo", MessageKey: "notFound", Message: "meeting not found on any server"}
+}
+
+// CreateWith places a meeting like Place, calls create with the chosen server
+// and assigns the meeting to it if create succeeds. Calls for the same meeting
+// are serialized, so that concurrent creates of a new meeting all end up on
+// the server the first one chose.
+func (p *Pool) CreateWith(ctx context.Context, meetingID string, create func(ctx context.Context, s *Server) error) (*Server, error) {
+	if meetingID == "" {
+		return nil, NewError(ErrMissingParam, "meetingID is required")
+	}
+
+	unlock := p.creating.lock(meetingID)
+	defer unlock()
+
+	s, err := p.Place(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	if err := create(ctx, s); err != nil {
+		return nil, err
+	}
+	if err := p.Assign(ctx, meetingID, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// CreateMeeting creates a meeting on the server chosen by the pool's strategy.
+// Creating a meeting that already exists is sent to its server, so that the
+// call stays idempotent as it is on a single server.
+func (p *Pool) CreateMeeting(ctx context.Context, req *requests.CreateMeetingRequest) (*responses.CreateMeetingResponse, error) {
+	if req == nil {
+		return nil, NewError(ErrInvalidParam, "request cannot be nil")
+	}
+
+	var resp *responses.CreateMeetingResponse
+	_, err := p.CreateWith(ctx, req.MeetingID, func(ctx context.Context, s *Server) error {
+		var err error
+		resp, err = s.Client.CreateMeeting(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// keyedMutex is a set of mutexes identified by a key, kept only while in use.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+// keyedLock is the mutex of a key and the number of goroutines holding or waiting for it.
+type keyedLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lock locks the mutex of key and returns the function unlocking it.
+func (k *keyedMutex) lock(key string) (unlock func()) {
+	k.mu.Lock()
+	if k.locks == nil {
+		k.locks = make(map[string]*keyedLock)
+	}
+	l := k.locks[key]
+	if l == nil {
+		l = &keyedLock{}
+		k.locks[key] = l
+	}
+	l.refs++
+	k.mu.Unlock()
+
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+		k.mu.Lock()
+		if l.refs--; l.refs == 0 {
+			delete(k.locks, key)
+		}
+		k.mu.Unlock()
+	}
+}
+
+// JoinMeeting returns the join URL of a meeting on the server hosting it.
+func (p *Pool) JoinMeeting(ctx context.Context, req *requests.JoinMeetingRequest) (string, error) {
+	if req == nil {
+		return "", NewError(ErrInvalidParam, "request cannot be nil")
+	}
+	s, err := p.ServerFor(ctx, req.MeetingID)
+	if err != nil {
+		return "", err
+	}
+	return s.Client.JoinMeeting(ctx, req)
+}
+
+// EndMeeting ends a meeting on the server hosting it.
+func (p *Pool) EndMeeting(ctx context.Context, req *requests.EndMeetingRequest) (*responses.EndMeetingResponse, error) {
+	if req == nil {
+		return nil, NewError(ErrInvalidParam, "request cannot be nil")
+	}
+	s, err := p.ServerFor(ctx, req.MeetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Client.EndMeeting(ctx, req)
+	if err == nil || errors.Is(err, ErrAPINotFound) {
+		if err := p.Forget(ctx, req.MeetingID); err != nil {
+			return nil, err
+		}
+	}
+	return resp, err
+}
+
+// GetMeetingInfo retrieves information about a meeting from the server hosting it.
+func (p *Pool) GetMeetingInfo(ctx context.Context, meetingID, password string) (*responses.GetMeetingInfoResponse, error) {
+	s, err := p.ServerFor(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Client.GetMeetingInfo(ctx, meetingID, password)
+	if errors.Is(err, ErrAPINotFound) {
+		if err := p.Forget(ctx, meetingID); err != nil {
+			return nil, err
+		}
+	}
+	return resp, err
+}
+
+// IsMeetingRunning checks if a meeting is running on the server hosting it.
+// Meetings unknown to every server are reported as not running.
+func (p *Pool) IsMeetingRunning(ctx context.Context, meetingID string) (bool, error) {
+	s, err := p.ServerFor(ctx, meetingID)
+	if errors.Is(err, ErrAPINotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return s.Client.IsMeetingRunning(ctx, meetingID)
+}
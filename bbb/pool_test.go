@@ -0,0 +1,233 @@
+package bbb_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNode is a BigBlueButton server keeping its meetings in memory.
+type fakeNode struct {
+	mu           sync.Mutex
+	meetings     map[string]int // meetingID → participant count
+	calls        map[string]int // action → number of calls
+	failMeetings bool
+}
+
+func newFakeNode(meetings map[string]int) *fakeNode {
+	if meetings == nil {
+		meetings = map[string]int{}
+	}
+	return &fakeNode{meetings: meetings, calls: map[string]int{}}
+}
+
+func (n *fakeNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	action := strings.TrimPrefix(r.URL.Path, "/api/")
+	n.calls[action]++
+	id := r.URL.Query().Get("meetingID")
+	_, exists := n.meetings[id]
+	notFound := `<response><returncode>FAILED</returncode><messageKey>notFound</messageKey><message>We could not find a meeting with that meeting ID</message></response>`
+
+	switch action {
+	case "getMeetings":
+		if n.failMeetings {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var b strings.Builder
+		b.WriteString(`<response><returncode>SUCCESS</returncode><meetings>`)
+		for id, participants := range n.meetings {
+			fmt.Fprintf(&b, `<meeting><meetingID>%s</meetingID><participantCount>%d</participantCount></meeting>`, id, participants)
+		}
+		b.WriteString(`</meetings></response>`)
+		w.Write([]byte(b.String()))
+	case "create":
+		if !exists {
+			n.meetings[id] = 0
+		}
+		fmt.Fprintf(w, `<response><returncode>SUCCESS</returncode><meetingID>%s</meetingID></response>`, id)
+	case "getMeetingInfo":
+		if !exists {
+			w.Write([]byte(notFound))
+			return
+		}
+		fmt.Fprintf(w, `<response><returncode>SUCCESS</returncode><meetingID>%s</meetingID><running>true</running></response>`, id)
+	case "isMeetingRunning":
+		fmt.Fprintf(w, `<response><returncode>SUCCESS</returncode><running>%t</running></response>`, exists)
+	case "end":
+		if !exists {
+			w.Write([]byte(notFound))
+			return
+		}
+		delete(n.meetings, id)
+		w.Write([]byte(`<response><returncode>SUCCESS</returncode><messageKey>sentEndMeetingRequest</messageKey></response>`))
+	}
+}
+
+// failingDeleteStore is a MeetingStore whose deletions fail.
+type failingDeleteStore struct {
+	*bbb.MemoryStore
+}
+
+func (failingDeleteStore) Delete(ctx context.Context, meetingID string) error {
+	return errors.New("store unavailable")
+}
+
+// newPool starts one fake server per node and returns a pool of them, named a, b, c, …
+func newPool(t *testing.T, nodes []*fakeNode, options ...bbb.PoolOption) *bbb.Pool {
+	t.Helper()
+
+	servers := make([]*bbb.Server, len(nodes))
+	for i, n := range nodes {
+		servers[i] = &bbb.Server{ID: string(rune('a' + i)), Client: bbb.NewTestClient(t, n.ServeHTTP)}
+	}
+	pool, err := bbb.NewPool(servers, options...)
+	require.NoError(t, err)
+	return pool
+}
+
+// -------------------- Pool --------------------
+
+func TestPool_CreateMeetingLeastMeetings(t *testing.T) {
+	a := newFakeNode(map[string]int{"m1": 10, "m2": 10})
+	b := newFakeNode(map[string]int{"m3": 50})
+	pool := newPool(t, []*fakeNode{a, b})
+
+	_, err := pool.CreateMeeting(context.Background(), &requests.CreateMeetingRequest{MeetingID: "new", Name: "New"})
+	require.NoError(t, err)
+
+	s, err := pool.ServerFor(context.Background(), "new")
+	require.NoError(t, err)
+	assert.Equal(t, "b", s.ID)
+	assert.Contains(t, b.meetings, "new")
+
+	// Creating the same meeting again goes to the same server without asking for loads.
+	_, err = pool.CreateMeeting(context.Background(), &requests.CreateMeetingRequest{MeetingID: "new", Name: "New"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, b.calls["create"])
+	assert.Equal(t, 1, b.calls["getMeetings"])
+}
+
+func TestPool_CreateMeetingConcurrent(t *testing.T) {
+	a, b := newFakeNode(nil), newFakeNode(nil)
+	pool := newPool(t, []*fakeNode{a, b}, bbb.WithStrategy(bbb.RoundRobin()))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := pool.CreateMeeting(context.Background(), &requests.CreateMeetingRequest{MeetingID: "room", Name: "Room"})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	_, onA := a.meetings["room"]
+	_, onB := b.meetings["room"]
+	assert.True(t, onA != onB, "the meeting is created on exactly one server")
+	assert.Equal(t, 10, a.calls["create"]+b.calls["create"])
+}
+
+func TestPool_CreateMeetingLeastParticipants(t *testing.T) {
+	a := newFakeNode(map[string]int{"m1": 10, "m2": 10})
+	b := newFakeNode(map[string]int{"m3": 50})
+	pool := newPool(t, []*fakeNode{a, b}, bbb.WithStrategy(bbb.LeastParticipants()))
+
+	_, err := pool.CreateMeeting(context.Background(), &requests.CreateMeetingRequest{MeetingID: "new", Name: "New"})
+	require.NoError(t, err)
+	assert.Contains(t, a.meetings, "new")
+}
+
+func TestPool_CreateMeetingNoServer(t *testing.T) {
+	a := newFakeNode(nil)
+	a.failMeetings = true
+	pool := newPool(t, []*fakeNode{a})
+
+	_, err := pool.CreateMeeting(context.Background(), &requests.CreateMeetingRequest{MeetingID: "new", Name: "New"})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, bbb.ErrNoServer))
+}
+
+func TestPool_Routing(t *testing.T) {
+	a := newFakeNode(nil)
+	b := newFakeNode(map[string]int{"existing": 3})
+	pool := newPool(t, []*fakeNode{a, b})
+	ctx := context.Background()
+
+	// The meeting was not created through the pool and is located on b.
+	info, err := pool.GetMeetingInfo(ctx, "existing", "")
+	require.NoError(t, err)
+	assert.Equal(t, "existing", info.MeetingID)
+
+	running, err := pool.IsMeetingRunning(ctx, "existing")
+	require.NoError(t, err)
+	assert.True(t, running)
+
+	joinURL, err := pool.JoinMeeting(ctx, &requests.JoinMeetingRequest{MeetingID: "existing", FullName: "Jane", Role: requests.RoleViewer})
+	require.NoError(t, err)
+	assert.Contains(t, joinURL, "meetingID=existing")
+	assert.Equal(t, 1, a.calls["getMeetingInfo"], "the location is remembered")
+
+	_, err = pool.EndMeeting(ctx, &requests.EndMeetingRequest{MeetingID: "existing"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, b.calls["end"])
+	assert.Equal(t, 0, a.calls["end"])
+
+	// Ended meetings are forgotten and reported as not found.
+	_, err = pool.GetMeetingInfo(ctx, "existing", "")
+	assert.True(t, errors.Is(err, bbb.ErrAPINotFound))
+
+	running, err = pool.IsMeetingRunning(ctx, "existing")
+	require.NoError(t, err)
+	assert.False(t, running)
+}
+
+func TestPool_ForgetError(t *testing.T) {
+	a := newFakeNode(map[string]int{"gone": 0})
+	pool := newPool(t, []*fakeNode{a}, bbb.WithMeetingStore(failingDeleteStore{bbb.NewMemoryStore()}))
+	ctx := context.Background()
+
+	_, err := pool.GetMeetingInfo(ctx, "gone", "")
+	require.NoError(t, err)
+
+	// The meeting ended outside the pool; failing to forget it is reported.
+	delete(a.meetings, "gone")
+	_, err = pool.GetMeetingInfo(ctx, "gone", "")
+	require.EqualError(t, err, "store unavailable")
+}
+
+func TestNewPool_Validation(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	tests := []struct {
+		name    string
+		servers []*bbb.Server
+		options []bbb.PoolOption
+	}{
+		{"no servers", nil, nil},
+		{"nil client", []*bbb.Server{{ID: "a"}}, nil},
+		{"missing ID", []*bbb.Server{{Client: client}}, nil},
+		{"duplicate ID", []*bbb.Server{{ID: "a", Client: client}, {ID: "a", Client: client}}, nil},
+		{"nil strategy", []*bbb.Server{{ID: "a", Client: client}}, []bbb.PoolOption{bbb.WithStrategy(nil)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := bbb.NewPool(tt.servers, tt.options...)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Package bbb provides a Go client for the BigBlueButton API.
+
+This file contains the low-level calls that send API requests with arbitrary
+parameters and return the raw response, for proxies and for API calls the
+client does not wrap.
+*/
+
+package bbb
+
+import (
+	"context"
+	"net/url"
+)
+
+// Call sends an API call with the given parameters and returns the response
+// body as received. Unlike the typed methods, a FAILED return code is not
+// turned into an error; only transport failures and unexpected HTTP statuses are.
+// The checksum is negotiated like for the typed methods.
+func (c *Client) Call(ctx context.Context, action string, params url.Values) ([]byte, error) {
+	return c.sendNegotiated(ctx, action, cloneParams(params), nil)
+}
+
+// CallWithBody is like Call but sends body as a POST request with the given content type.
+func (c *Client) CallWithBody(ctx context.Context, action string, params url.Values, contentType string, body []byte) ([]byte, error) {
+	payload := &requestBody{contentType: contentType, data: body}
+	return c.sendNegotiated(ctx, action, cloneParams(params), payload)
+}
+
+// SignedURL returns the URL of an API call with its checksum, e.g. to redirect
+// a browser to a join URL built from arbitrary parameters.
+func (c *Client) SignedURL(action string, params url.Values) string {
+	params = cloneParams(params)
+	params.Del("checksum")
+	params.Set("checksum", c.generateChecksum(action, params))
+	return c.baseURL + action + "?" + params.Encode()
+}
+
+// cloneParams returns a copy of params that can be modified freely.
+func cloneParams(params url.Values) url.Values {
+	out := make(url.Values, len(params))
+	for k, v := range params {
+		out[k] = append([]string(nil), v...)
+	}
+	return out
+}
@@ -0,0 +1,52 @@
+package bbb_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------- Call --------------------
+
+func TestCall(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/getMeetingInfo", r.URL.Path)
+		assert.True(t, bbb.VerifyChecksum("getMeetingInfo", r.URL.RawQuery, "test-secret"))
+		w.Write([]byte(`<response><returncode>FAILED</returncode><messageKey>notFound</messageKey></response>`))
+	})
+
+	params := url.Values{"meetingID": {"m1"}}
+	body, err := client.Call(context.Background(), "getMeetingInfo", params)
+	require.NoError(t, err, "FAILED responses are returned as they are")
+	assert.Contains(t, string(body), "notFound")
+	assert.Empty(t, params.Get("checksum"), "the parameters are not modified")
+}
+
+func TestCallWithBody(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/xml", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "<modules/>", string(body))
+		w.Write([]byte(`<response><returncode>SUCCESS</returncode></response>`))
+	})
+
+	_, err := client.CallWithBody(context.Background(), "create", url.Values{"meetingID": {"m1"}}, "application/xml", []byte("<modules/>"))
+	require.NoError(t, err)
+}
+
+func TestSignedURL(t *testing.T) {
+	client, err := bbb.NewClient("https://bbb.example.com/bigbluebutton/", "secret")
+	require.NoError(t, err)
+
+	u, err := url.Parse(client.SignedURL("join", url.Values{"meetingID": {"m1"}, "checksum": {"stale"}}))
+	require.NoError(t, err)
+	assert.Equal(t, "/bigbluebutton/api/join", u.Path)
+	assert.True(t, bbb.VerifyChecksum("join", u.RawQuery, "secret"))
+}
@@ -0,0 +1,178 @@
+/*
+Package bbb provides a Go client for the BigBlueButton API.
+
+This file contains the hook reconciler, which keeps the webhooks registered
+on a server in line with a declared set.
+*/
+
+package bbb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
+)
+
+// HookSpec describes a webhook that should be registered.
+type HookSpec struct {
+	CallbackURL string   // Required
+	MeetingID   string   // Empty for a hook receiving events of all meetings
+	GetRaw      bool     // Receive events in the raw format
+	EventIDs    []string // Only receive these events; empty for all events
+}
+
+// key identifies the spec independently of the order, case and repetition of
+// its event IDs, which bbb-webhooks may return normalized.
+func (s HookSpec) key() string {
+	seen := make(map[string]bool, len(s.EventIDs))
+	ids := make([]string, 0, len(s.EventIDs))
+	for _, id := range s.EventIDs {
+		id = strings.ToLower(strings.TrimSpace(id))
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return fmt.Sprintf("%s\x00%s\x00%t\x00%s", s.CallbackURL, s.MeetingID, s.GetRaw, strings.Join(ids, ","))
+}
+
+// specOf returns the spec an existing hook was created from.
+func specOf(h responses.HookDetails) HookSpec {
+	return HookSpec{
+		CallbackURL: h.CallbackURL,
+		MeetingID:   h.MeetingID,
+		GetRaw:      h.Raw,
+		EventIDs:    h.EventIDs(),
+	}
+}
+
+// HookReconciler creates and destroys webhooks so that the hooks registered on
+// the server match Hooks. Hooks that are no longer wanted and duplicates of
+// wanted hooks are destroyed, missing hooks are created. Permanent hooks from
+// the server configuration are never touched.
+type HookReconciler struct {
+	Client *Client
+	Hooks  []HookSpec
+
+	// Managed reports whether an existing hook belongs to this reconciler.
+	// Hooks it does not manage are left alone. By default, a hook is managed
+	// when its callback URL is the callback URL of one of Hooks.
+	Managed func(h responses.HookDetails) bool
+
+	// OnError is called by Run when a reconciliation fails.
+	OnError func(err error)
+}
+
+// ReconcileResult reports the changes made by a reconciliation.
+type ReconcileResult struct {
+	Created   []string // IDs of the created hooks
+	Destroyed []string // IDs of the destroyed hooks
+	Kept      []string // IDs of the existing hooks matching a spec
+}
+
+// Reconcile lists the registered hooks and creates or destroys hooks until they
+// match the desired set. It carries on after a failed create or destroy and
+// returns all failures joined together with the changes that were made.
+func (r *HookReconciler) Reconcile(ctx context.Context) (*ReconcileResult, error) {
+	if r.Client == nil {
+		return nil, NewError(ErrInvalidParam, "client cannot be nil")
+	}
+	for _, spec := range r.Hooks {
+		if spec.CallbackURL == "" {
+			return nil, NewError(ErrMissingParam, "callbackURL is required")
+		}
+	}
+
+	list, err := r.Client.ListHooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]HookSpec, len(r.Hooks))
+	for _, spec := range r.Hooks {
+		wanted[spec.key()] = spec
+	}
+
+	managed := r.Managed
+	if managed == nil {
+		urls := make(map[string]bool, len(r.Hooks))
+		for _, spec := range r.Hooks {
+			urls[spec.CallbackURL] = true
+		}
+		managed = func(h responses.HookDetails) bool { return urls[h.CallbackURL] }
+	}
+
+	result := &ReconcileResult{}
+	var errs []error
+	found := make(map[string]bool, len(wanted))
+	for _, h := range list.Hooks {
+		if h.Permanent || !managed(h) {
+			continue
+		}
+
+		key := specOf(h).key()
+		if _, ok := wanted[key]; ok && !found[key] {
+			found[key] = true
+			result.Kept = append(result.Kept, h.ID)
+			continue
+		}
+
+		if _, err := r.Client.DestroyHook(ctx, h.ID); err != nil {
+			errs = append(errs, fmt.Errorf("destroying hook %s: %w", h.ID, err))
+			continue
+		}
+		result.Destroyed = append(result.Destroyed, h.ID)
+	}
+
+	for _, spec := range r.Hooks {
+		key := spec.key()
+		if found[key] {
+			continue
+		}
+		found[key] = true
+
+		resp, err := r.Client.CreateHook(ctx, &requests.CreateHookRequest{
+			CallbackURL: spec.CallbackURL,
+			MeetingID:   spec.MeetingID,
+			GetRaw:      spec.GetRaw,
+			EventIDs:    spec.EventIDs,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("creating hook for %s: %w", spec.CallbackURL, err))
+			continue
+		}
+		result.Created = append(result.Created, resp.HookID)
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// Run reconciles immediately and then every interval until ctx is done, which
+// is the only way it returns. Failures are passed to OnError.
+func (r *HookReconciler) Run(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return NewError(ErrInvalidParam, "interval must be positive")
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.Reconcile(ctx); err != nil && ctx.Err() == nil && r.OnError != nil {
+			r.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package bbb_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeHook is a hook registered on a fakeHookServer.
+type fakeHook struct {
+	id, callbackURL, meetingID, eventID string
+	raw, permanent                      bool
+}
+
+// fakeHookServer implements hooks/list, hooks/create and hooks/destroy in memory.
+type fakeHookServer struct {
+	mu     sync.Mutex
+	hooks  []fakeHook
+	nextID int
+	calls  []string
+
+	lowercase bool // Store event IDs lowercased, as some bbb-webhooks versions do
+}
+
+func (s *fakeHookServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q := r.URL.Query()
+	action := strings.TrimPrefix(r.URL.Path, "/api/")
+	s.calls = append(s.calls, action)
+
+	switch action {
+	case "hooks/list":
+		var b strings.Builder
+		b.WriteString(`<response><returncode>SUCCESS</returncode><hooks>`)
+		for _, h := range s.hooks {
+			fmt.Fprintf(&b, `<hook><hookID>%s</hookID><callbackURL><![CDATA[%s]]></callbackURL><meetingID>%s</meetingID><eventID>%s</eventID><permanentHook>%t</permanentHook><rawData>%t</rawData></hook>`,
+				h.id, h.callbackURL, h.meetingID, h.eventID, h.permanent, h.raw)
+		}
+		b.WriteString(`</hooks></response>`)
+		w.Write([]byte(b.String()))
+	case "hooks/create":
+		s.nextID++
+		h := fakeHook{
+			id:          fmt.Sprintf("new-%d", s.nextID),
+			callbackURL: q.Get("callbackURL"),
+			meetingID:   q.Get("meetingID"),
+			eventID:     q.Get("eventID"),
+			raw:         q.Get("getRaw") == "true",
+		}
+		if s.lowercase {
+			h.eventID = strings.ToLower(h.eventID)
+		}
+		s.hooks = append(s.hooks, h)
+		fmt.Fprintf(w, `<response><returncode>SUCCESS</returncode><hookID>%s</hookID></response>`, h.id)
+	case "hooks/destroy":
+		for i, h := range s.hooks {
+			if h.id == q.Get("hookID") {
+				s.hooks = append(s.hooks[:i], s.hooks[i+1:]...)
+				w.Write([]byte(`<response><returncode>SUCCESS</returncode><removed>true</removed></response>`))
+				return
+			}
+		}
+		w.Write([]byte(`<response><returncode>FAILED</returncode><messageKey>destroyMissingHook</messageKey></response>`))
+	}
+}
+
+// -------------------- HookReconciler --------------------
+
+func TestHookReconciler_Reconcile(t *testing.T) {
+	srv := &fakeHookServer{hooks: []fakeHook{
+		{id: "1", callbackURL: "https://example.com/hooks", eventID: "user-left,user-joined"},
+		{id: "2", callbackURL: "https://example.com/hooks", eventID: "user-joined,user-left"}, // duplicate
+		{id: "3", callbackURL: "https://example.com/hooks", meetingID: "old-meeting"},         // no longer wanted
+		{id: "4", callbackURL: "https://other.example.com/hooks"},                             // not managed
+		{id: "5", callbackURL: "https://example.com/hooks", permanent: true},                  // from server config
+	}}
+	client := bbb.NewTestClient(t, srv.ServeHTTP)
+
+	r := &bbb.HookReconciler{
+		Client: client,
+		Hooks: []bbb.HookSpec{
+			{CallbackURL: "https://example.com/hooks", EventIDs: []string{"user-joined", "user-left"}},
+			{CallbackURL: "https://example.com/hooks", GetRaw: true},
+		},
+	}
+
+	result, err := r.Reconcile(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1"}, result.Kept)
+	assert.Equal(t, []string{"2", "3"}, result.Destroyed)
+	assert.Equal(t, []string{"new-1"}, result.Created)
+
+	require.Len(t, srv.hooks, 4)
+	assert.True(t, srv.hooks[3].raw)
+
+	// A second run has nothing to do.
+	result, err = r.Reconcile(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, result.Created)
+	assert.Empty(t, result.Destroyed)
+	assert.ElementsMatch(t, []string{"1", "new-1"}, result.Kept)
+}
+
+func TestHookReconciler_EventIDCase(t *testing.T) {
+	srv := &fakeHookServer{lowercase: true}
+	client := bbb.NewTestClient(t, srv.ServeHTTP)
+
+	r := &bbb.HookReconciler{
+		Client: client,
+		Hooks: []bbb.HookSpec{
+			{CallbackURL: "https://example.com/hooks", EventIDs: []string{"User-Joined", "meeting-ended", "user-joined"}},
+		},
+	}
+
+	result, err := r.Reconcile(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"new-1"}, result.Created)
+
+	// The lowercased hook still matches the spec.
+	result, err = r.Reconcile(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, result.Created)
+	assert.Empty(t, result.Destroyed)
+	assert.Equal(t, []string{"new-1"}, result.Kept)
+}
+
+func TestHookReconciler_CustomManaged(t *testing.T) {
+	srv := &fakeHookServer{hooks: []fakeHook{
+		{id: "1", callbackURL: "https://old.example.com/hooks"},
+	}}
+	client := bbb.NewTestClient(t, srv.ServeHTTP)
+
+	r := &bbb.HookReconciler{
+		Client:  client,
+		Hooks:   []bbb.HookSpec{{CallbackURL: "https://new.example.com/hooks"}},
+		Managed: func(h responses.HookDetails) bool { return strings.HasSuffix(h.CallbackURL, ".example.com/hooks") },
+	}
+
+	result, err := r.Reconcile(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1"}, result.Destroyed)
+	assert.Equal(t, []string{"new-1"}, result.Created)
+}
+
+func TestHookReconciler_Validation(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request expected")
+	})
+
+	_, err := (&bbb.HookReconciler{}).Reconcile(context.Background())
+	require.Error(t, err)
+
+	_, err = (&bbb.HookReconciler{Client: client, Hooks: []bbb.HookSpec{{}}}).Reconcile(context.Background())
+	require.Error(t, err)
+
+	err = (&bbb.HookReconciler{Client: client}).Run(context.Background(), 0)
+	require.Error(t, err)
+}
+
+func TestHookReconciler_Run(t *testing.T) {
+	srv := &fakeHookServer{}
+	client := bbb.NewTestClient(t, srv.ServeHTTP)
+
+	r := &bbb.HookReconciler{
+		Client: client,
+		Hooks:  []bbb.HookSpec{{CallbackURL: "https://example.com/hooks"}},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- r.Run(ctx, 10*time.Millisecond) }()
+
+	// Simulate a server restart dropping the hook.
+	require.Eventually(t, func() bool {
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+		if len(srv.hooks) == 1 && srv.hooks[0].id == "new-1" {
+			srv.hooks = nil
+		}
+		return len(srv.hooks) == 1 && srv.hooks[0].id == "new-2"
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
 	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
@@ -20,35 +21,71 @@ func (c *Client) GetRecordings(ctx context.Context, req *requests.GetRecordingsR
 		req = &requests.GetRecordingsRequest{}
 	}
 
-	params := url.Values{}
+	params, err := getRecordingsParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the API call
+	var response responses.GetRecordingsResponse
+	if err := c.doRequest(ctx, "getRecordings", params, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
 
-	// Add optional parameters
-	if req.MeetingID != "" {
-		params.Set("meetingID", req.MeetingID)
+// getRecordingsParams validates a getRecordings request and builds its query parameters.
+func getRecordingsParams(req *requests.GetRecordingsRequest) (url.Values, error) {
+	if err := validateIDs("meetingID", req.MeetingIDs); err != nil {
+		return nil, err
 	}
-	if req.RecordID != "" {
-		params.Set("recordID", req.RecordID)
+	if err := validateIDs("recordID", req.RecordIDs); err != nil {
+		return nil, err
 	}
-	if req.State != "" {
-		params.Set("state", req.State)
+	states := make([]string, len(req.States))
+	for i, state := range req.States {
+		if !state.Valid() {
+			return nil, NewError(ErrInvalidParam, "invalid recording state: "+string(state))
+		}
+		states[i] = string(state)
 	}
-	if req.Meta != "" {
-		params.Set("meta", req.Meta)
+	for k := range req.Meta {
+		if k == "" || strings.ContainsAny(k, " =&") {
+			return nil, NewError(ErrInvalidParam, "invalid meta filter key: "+k)
+		}
 	}
-	if req.Offset > 0 {
-		params.Set("offset", strconv.Itoa(req.Offset))
+	if req.Offset < 0 {
+		return nil, NewError(ErrInvalidParam, "offset cannot be negative")
 	}
-	if req.Limit > 0 {
-		params.Set("limit", strconv.Itoa(req.Limit))
+	if req.Limit < 0 || req.Limit > maxRecordingsPageSize {
+		return nil, NewError(ErrInvalidParam, "limit must be between 1 and 100")
 	}
 
-	// Make the API call
-	var response responses.GetRecordingsResponse
-	if err := c.doRequest(ctx, "getRecordings", params, &response); err != nil {
-		return nil, err
+	params := url.Values{}
+	setString(params, "meetingID", strings.Join(req.MeetingIDs, ","))
+	setString(params, "recordID", strings.Join(req.RecordIDs, ","))
+	setString(params, "state", strings.Join(states, ","))
+	for k, v := range req.Meta {
+		params.Set("meta_"+k, v)
 	}
+	setInt(params, "offset", req.Offset)
+	setInt(params, "limit", req.Limit)
 
-	return &response, nil
+	return params, nil
+}
+
+// validateIDs checks that a list of IDs can be sent comma-separated.
+func validateIDs(name string, ids []string) error {
+	for _, id := range ids {
+		if id == "" {
+			return NewError(ErrInvalidParam, name+" cannot be empty")
+		}
+		if strings.Contains(id, ",") {
+			return NewError(ErrInvalidParam, name+" cannot contain a comma: "+id)
+		}
+	}
+	return nil
 }
 
 // PublishRecordings publishes or unpublishes a recording.
@@ -0,0 +1,277 @@
+/*
+Package bbb provides functionality for managing BigBlueButton recordings.
+This file contains the bulk variants of publishRecordings, deleteRecordings
+and updateRecordings, which split long lists of recordings into batches.
+*/
+
+package bbb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+)
+
+// RecordingOutcome is the outcome of a bulk operation for one recording.
+type RecordingOutcome string
+
+// Outcomes of a bulk operation.
+const (
+	RecordingSucceeded RecordingOutcome = "succeeded"
+	RecordingNotFound  RecordingOutcome = "notFound"
+	RecordingFailed    RecordingOutcome = "failed"
+)
+
+// RecordingResult is the result of a bulk operation for one recording.
+type RecordingResult struct {
+	RecordID string
+	Outcome  RecordingOutcome
+	Err      error // Set when the outcome is RecordingFailed
+}
+
+// BulkReport lists the result of a bulk operation for each recording, in the
+// order the recordings were given.
+type BulkReport struct {
+	Results []RecordingResult
+}
+
+// Succeeded returns the IDs of the recordings the operation was applied to.
+func (r *BulkReport) Succeeded() []string {
+	return r.ids(RecordingSucceeded)
+}
+
+// NotFound returns the IDs of the recordings that do not exist.
+func (r *BulkReport) NotFound() []string {
+	return r.ids(RecordingNotFound)
+}
+
+// Failed returns the results of the recordings the operation failed for.
+func (r *BulkReport) Failed() []RecordingResult {
+	var out []RecordingResult
+	for _, res := range r.Results {
+		if res.Outcome == RecordingFailed {
+			out = append(out, res)
+		}
+	}
+	return out
+}
+
+// ids returns the IDs of the recordings with the given outcome.
+func (r *BulkReport) ids(outcome RecordingOutcome) []string {
+	var out []string
+	for _, res := range r.Results {
+		if res.Outcome == outcome {
+			out = append(out, res.RecordID)
+		}
+	}
+	return out
+}
+
+// bulkConfig holds the settings of a bulk operation.
+type bulkConfig struct {
+	maxURLLength int
+	concurrency  int
+}
+
+// BulkOption configures a bulk recording operation.
+type BulkOption func(*bulkConfig)
+
+// WithMaxURLLength sets the length that the URL of a batch must stay under.
+// The default of 2048 characters is accepted by every common proxy.
+func WithMaxURLLength(n int) BulkOption {
+	return func(c *bulkConfig) {
+		if n > 0 {
+			c.maxURLLength = n
+		}
+	}
+}
+
+// WithConcurrency sets how many batches are sent at the same time. The default is 4.
+func WithConcurrency(n int) BulkOption {
+	return func(c *bulkConfig) {
+		if n > 0 {
+			c.concurrency = n
+		}
+	}
+}
+
+// urlOverhead is the room kept in a batch URL for the action, the checksum
+// and the parameters other than recordID.
+const urlOverhead = 200
+
+// BulkPublishRecordings publishes or unpublishes many recordings.
+func (c *Client) BulkPublishRecordings(ctx context.Context, recordIDs []string, publish bool, options ...BulkOption) (*BulkReport, error) {
+	return c.bulkRecordings(ctx, recordIDs, 0, options, func(ids string) error {
+		_, err := c.PublishRecordings(ctx, &requests.PublishRecordingsRequest{RecordID: ids, Publish: publish})
+		return err
+	})
+}
+
+// BulkDeleteRecordings deletes many recordings.
+func (c *Client) BulkDeleteRecordings(ctx context.Context, recordIDs []string, options ...BulkOption) (*BulkReport, error) {
+	return c.bulkRecordings(ctx, recordIDs, 0, options, func(ids string) error {
+		_, err := c.DeleteRecordings(ctx, ids)
+		return err
+	})
+}
+
+// BulkUpdateRecordings sets the same metadata on many recordings.
+func (c *Client) BulkUpdateRecordings(ctx context.Context, recordIDs []string, meta map[string]string, options ...BulkOption) (*BulkReport, error) {
+	metaLength := 0
+	for k, v := range meta {
+		metaLength += len("&meta_") + len(url.QueryEscape(k)) + 1 + len(url.QueryEscape(v))
+	}
+	return c.bulkRecordings(ctx, recordIDs, metaLength, options, func(ids string) error {
+		_, err := c.UpdateRecordings(ctx, &requests.UpdateRecordingsRequest{RecordID: ids, Meta: meta})
+		return err
+	})
+}
+
+// bulkRecordings splits recordIDs into batches whose URL fits the configured
+// length once extra bytes of other parameters are added, and applies apply to
+// each batch concurrently. The recordings of a batch are looked up first, since
+// the API succeeds as soon as one recording of a list exists.
+func (c *Client) bulkRecordings(ctx context.Context, recordIDs []string, extra int, options []BulkOption, apply func(ids string) error) (*BulkReport, error) {
+	if len(recordIDs) == 0 {
+		return nil, NewError(ErrMissingParam, "at least one recordID is required")
+	}
+	if err := validateIDs("recordID", recordIDs); err != nil {
+		return nil, err
+	}
+
+	cfg := bulkConfig{maxURLLength: 2048, concurrency: 4}
+	for _, option := range options {
+		option(&cfg)
+	}
+
+	ids := uniqueIDs(recordIDs)
+	budget := cfg.maxURLLength - len(c.baseURL) - urlOverhead - extra
+	batches := splitBatches(ids, budget)
+
+	outcomes := make(map[string]RecordingResult, len(ids))
+	var mu sync.Mutex
+	record := func(results []RecordingResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, r := range results {
+			outcomes[r.RecordID] = r
+		}
+	}
+
+	sem := make(chan struct{}, cfg.concurrency)
+	var wg sync.WaitGroup
+	for _, batch := range batches {
+		wg.Add(1)
+		go func(batch []string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+				record(c.applyBatch(ctx, batch, apply))
+			case <-ctx.Done():
+				record(failAll(batch, ctx.Err()))
+			}
+		}(batch)
+	}
+	wg.Wait()
+
+	report := &BulkReport{Results: make([]RecordingResult, len(ids))}
+	for i, id := range ids {
+		report.Results[i] = outcomes[id]
+	}
+	return report, nil
+}
+
+// applyBatch looks up the recordings of a batch and applies the operation to
+// those that exist.
+func (c *Client) applyBatch(ctx context.Context, batch []string, apply func(ids string) error) []RecordingResult {
+	existing := make(map[string]bool, len(batch))
+	it := c.IterateRecordings(ctx, &requests.GetRecordingsRequest{
+		RecordIDs: batch,
+		States:    []requests.RecordingState{requests.RecordingStateAny},
+	})
+	for it.Next() {
+		existing[it.Recording().RecordID] = true
+	}
+	if err := it.Err(); err != nil {
+		return failAll(batch, fmt.Errorf("looking up recordings: %w", err))
+	}
+
+	results := make([]RecordingResult, 0, len(batch))
+	var found []string
+	for _, id := range batch {
+		if existing[id] {
+			found = append(found, id)
+		} else {
+			results = append(results, RecordingResult{RecordID: id, Outcome: RecordingNotFound})
+		}
+	}
+	if len(found) == 0 {
+		return results
+	}
+
+	err := apply(strings.Join(found, ","))
+	for _, id := range found {
+		switch {
+		case err == nil:
+			results = append(results, RecordingResult{RecordID: id, Outcome: RecordingSucceeded})
+		case errors.Is(err, ErrAPINotFound):
+			results = append(results, RecordingResult{RecordID: id, Outcome: RecordingNotFound})
+		default:
+			results = append(results, RecordingResult{RecordID: id, Outcome: RecordingFailed, Err: err})
+		}
+	}
+	return results
+}
+
+// failAll returns a failed result with err for every recording of a batch.
+func failAll(batch []string, err error) []RecordingResult {
+	results := make([]RecordingResult, len(batch))
+	for i, id := range batch {
+		results[i] = RecordingResult{RecordID: id, Outcome: RecordingFailed, Err: err}
+	}
+	return results
+}
+
+// uniqueIDs returns ids without duplicates, keeping the first occurrence of each.
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// splitBatches groups ids so that each group, escaped and comma-separated,
+// takes at most budget bytes. An ID longer than the budget gets a batch of its own.
+func splitBatches(ids []string, budget int) [][]string {
+	var batches [][]string
+	var current []string
+	size := 0
+	for _, id := range ids {
+		n := len(url.QueryEscape(id))
+		if len(current) > 0 {
+			n += len("%2C")
+		}
+		if len(current) > 0 && size+n > budget {
+			batches = append(batches, current)
+			current, size = nil, 0
+			n = len(url.QueryEscape(id))
+		}
+		current = append(current, id)
+		size += n
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+	return batches
+}
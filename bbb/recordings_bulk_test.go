@@ -0,0 +1,140 @@
+package bbb_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bulkServer is a fake server holding a set of recordings. It records the
+// recordID list of every publish, delete and update call.
+type bulkServer struct {
+	mu       sync.Mutex
+	existing map[string]bool
+	fail     map[string]bool // IDs whose mutation makes the server fail
+	batches  [][]string
+	urls     []int // Length of the URL of every mutation call
+}
+
+func newBulkServer(t *testing.T, existing ...string) (*bulkServer, *bbb.Client) {
+	t.Helper()
+
+	s := &bulkServer{existing: map[string]bool{}, fail: map[string]bool{}}
+	for _, id := range existing {
+		s.existing[id] = true
+	}
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("recordID"), ",")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if strings.HasSuffix(r.URL.Path, "/getRecordings") {
+			var b strings.Builder
+			fmt.Fprint(&b, `<response><returncode>SUCCESS</returncode><recordings>`)
+			for _, id := range ids {
+				if s.existing[id] {
+					fmt.Fprintf(&b, `<recording><recordID>%s</recordID></recording>`, id)
+				}
+			}
+			fmt.Fprint(&b, `</recordings></response>`)
+			w.Write([]byte(b.String()))
+			return
+		}
+
+		s.batches = append(s.batches, ids)
+		s.urls = append(s.urls, len("http://"+r.Host+r.URL.RequestURI()))
+		for _, id := range ids {
+			if s.fail[id] {
+				w.Write([]byte(`<response><returncode>FAILED</returncode><messageKey>internalError</messageKey><message>boom</message></response>`))
+				return
+			}
+		}
+		for _, id := range ids {
+			if s.existing[id] {
+				w.Write([]byte(`<response><returncode>SUCCESS</returncode><published>true</published><deleted>true</deleted><updated>true</updated></response>`))
+				return
+			}
+		}
+		w.Write([]byte(`<response><returncode>FAILED</returncode><messageKey>notFound</messageKey><message>no recordings</message></response>`))
+	})
+	return s, client
+}
+
+// -------------------- Bulk Recordings --------------------
+
+func TestBulkPublishRecordings(t *testing.T) {
+	s, client := newBulkServer(t, "rec-1", "rec-3")
+
+	report, err := client.BulkPublishRecordings(context.Background(), []string{"rec-1", "rec-2", "rec-3", "rec-1"}, true)
+	require.NoError(t, err)
+
+	require.Len(t, report.Results, 3, "duplicates are dropped")
+	assert.Equal(t, []string{"rec-1", "rec-3"}, report.Succeeded())
+	assert.Equal(t, []string{"rec-2"}, report.NotFound())
+	assert.Empty(t, report.Failed())
+	assert.Equal(t, [][]string{{"rec-1", "rec-3"}}, s.batches, "missing recordings are not sent")
+}
+
+func TestBulkDeleteRecordings_Batches(t *testing.T) {
+	ids := make([]string, 50)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("recording-%02d", i)
+	}
+	s, client := newBulkServer(t, ids...)
+
+	report, err := client.BulkDeleteRecordings(context.Background(), ids, bbb.WithMaxURLLength(500), bbb.WithConcurrency(3))
+	require.NoError(t, err)
+
+	assert.Equal(t, ids, report.Succeeded(), "results keep the input order")
+	assert.Greater(t, len(s.batches), 1)
+	var sent []string
+	for i, batch := range s.batches {
+		assert.LessOrEqual(t, s.urls[i], 500)
+		sent = append(sent, batch...)
+	}
+	assert.ElementsMatch(t, ids, sent)
+}
+
+func TestBulkUpdateRecordings_Failure(t *testing.T) {
+	s, client := newBulkServer(t, "rec-1", "rec-2")
+	s.fail["rec-2"] = true
+
+	report, err := client.BulkUpdateRecordings(context.Background(), []string{"rec-1", "rec-2", "rec-9"}, map[string]string{"name": "Lecture"})
+	require.NoError(t, err)
+
+	assert.Empty(t, report.Succeeded())
+	assert.Equal(t, []string{"rec-9"}, report.NotFound())
+	failed := report.Failed()
+	require.Len(t, failed, 2)
+	for _, res := range failed {
+		assert.Equal(t, bbb.RecordingFailed, res.Outcome)
+		assert.ErrorContains(t, res.Err, "boom")
+	}
+}
+
+func TestBulkRecordings_Validation(t *testing.T) {
+	_, client := newBulkServer(t)
+
+	tests := []struct {
+		name string
+		ids  []string
+		code string
+	}{
+		{"no IDs", nil, bbb.ErrMissingParam},
+		{"empty ID", []string{"rec-1", ""}, bbb.ErrInvalidParam},
+		{"comma", []string{"rec-1,rec-2"}, bbb.ErrInvalidParam},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.BulkDeleteRecordings(context.Background(), tt.ids)
+			assert.True(t, bbb.IsError(err, tt.code))
+		})
+	}
+}
@@ -0,0 +1,40 @@
+//go:build go1.23
+
+/*
+Package bbb provides functionality for managing BigBlueButton recordings.
+This file contains the range-over-func variant of the recordings iterator.
+*/
+
+package bbb
+
+import (
+	"context"
+	"iter"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
+)
+
+// AllRecordings returns a sequence of the recordings matching req, fetched
+// page by page as with IterateRecordings. A failed call ends the sequence with
+// the error:
+//
+//	for rec, err := range client.AllRecordings(ctx, req) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (c *Client) AllRecordings(ctx context.Context, req *requests.GetRecordingsRequest) iter.Seq2[responses.Recording, error] {
+	return func(yield func(responses.Recording, error) bool) {
+		it := c.IterateRecordings(ctx, req)
+		for it.Next() {
+			if !yield(it.Recording(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			yield(responses.Recording{}, err)
+		}
+	}
+}
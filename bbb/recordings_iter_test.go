@@ -0,0 +1,51 @@
+//go:build go1.23
+
+package bbb_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------- AllRecordings --------------------
+
+func TestAllRecordings(t *testing.T) {
+	var calls atomic.Int32
+	client := recordingsServer(t, 25, true, &calls)
+
+	var ids []string
+	for rec, err := range client.AllRecordings(context.Background(), &requests.GetRecordingsRequest{Limit: 10}) {
+		require.NoError(t, err)
+		ids = append(ids, rec.RecordID)
+	}
+	assert.Len(t, ids, 25)
+
+	// Breaking out early stops fetching pages.
+	calls.Store(0)
+	for rec := range client.AllRecordings(context.Background(), &requests.GetRecordingsRequest{Limit: 10}) {
+		if rec.RecordID == "rec-3" {
+			break
+		}
+	}
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestAllRecordings_Error(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	var errs []error
+	for _, err := range client.AllRecordings(context.Background(), nil) {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	assert.Error(t, errs[0])
+}
@@ -0,0 +1,131 @@
+/*
+Package bbb provides functionality for managing BigBlueButton recordings.
+This file contains the iterator walking the pages of getRecordings.
+*/
+
+package bbb
+
+import (
+	"context"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
+)
+
+// maxRecordingsPageSize is the largest limit BigBlueButton accepts on getRecordings.
+const maxRecordingsPageSize = 100
+
+// RecordingsIterator walks the recordings matching a GetRecordingsRequest,
+// fetching one page at a time as it goes:
+//
+//	it := client.IterateRecordings(ctx, &requests.GetRecordingsRequest{
+//		States: []requests.RecordingState{requests.RecordingStatePublished},
+//	})
+//	for it.Next() {
+//		rec := it.Recording()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type RecordingsIterator struct {
+	ctx    context.Context
+	client *Client
+	req    requests.GetRecordingsRequest
+
+	page    []responses.Recording
+	current responses.Recording
+	firstID string // First recording of the first page
+	total   int
+	done    bool
+	err     error
+}
+
+// IterateRecordings returns an iterator over the recordings matching req, which
+// may be nil. Pages hold req.Limit recordings, or 100 when it is unset; paging
+// starts at req.Offset. Servers older than BigBlueButton 2.6 ignore paging and
+// return every recording in a single page.
+func (c *Client) IterateRecordings(ctx context.Context, req *requests.GetRecordingsRequest) *RecordingsIterator {
+	it := &RecordingsIterator{ctx: ctx, client: c}
+	if req != nil {
+		it.req = *req
+	}
+	if it.req.Limit <= 0 || it.req.Limit > maxRecordingsPageSize {
+		it.req.Limit = maxRecordingsPageSize
+	}
+	return it
+}
+
+// Next advances to the next recording, fetching the next page when needed.
+// It returns false when there are no more recordings, when a call fails or
+// when the context is done; Err tells them apart.
+func (it *RecordingsIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	if len(it.page) == 0 {
+		if it.done {
+			return false
+		}
+		if !it.fetch() {
+			return false
+		}
+	}
+
+	it.current, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// fetch loads the next page and reports whether it holds any recordings.
+func (it *RecordingsIterator) fetch() bool {
+	req := it.req
+	resp, err := it.client.GetRecordings(it.ctx, &req)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	// A server ignoring paging returns the first page again.
+	if len(resp.Recordings) > 0 {
+		if it.firstID != "" && resp.Recordings[0].RecordID == it.firstID {
+			it.done = true
+			return false
+		}
+		if it.firstID == "" {
+			it.firstID = resp.Recordings[0].RecordID
+		}
+	}
+
+	it.page = resp.Recordings
+	it.total = resp.TotalElements
+	it.req.Offset += len(resp.Recordings)
+
+	// A short page is the last one, and a page larger than the limit means the
+	// server does not support paging and returned everything.
+	if len(resp.Recordings) != it.req.Limit || (it.total > 0 && it.req.Offset >= it.total) {
+		it.done = true
+	}
+	return len(it.page) > 0
+}
+
+// Recording returns the current recording.
+func (it *RecordingsIterator) Recording() responses.Recording {
+	return it.current
+}
+
+// Total returns the number of matching recordings over all pages, as reported
+// by the server with the last page fetched. It is 0 before the first call to
+// Next and on servers that do not report it.
+func (it *RecordingsIterator) Total() int {
+	return it.total
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *RecordingsIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,130 @@
+package bbb_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingsServer returns a client for a server holding n recordings, paged
+// like BigBlueButton 2.6+ unless paging is false. calls counts the requests.
+func recordingsServer(t *testing.T, n int, paging bool, calls *atomic.Int32) *bbb.Client {
+	t.Helper()
+
+	return bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		q := r.URL.Query()
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		if !paging {
+			offset, limit = 0, n
+		}
+
+		var b strings.Builder
+		fmt.Fprint(&b, `<response><returncode>SUCCESS</returncode><recordings>`)
+		for i := offset; i < offset+limit && i < n; i++ {
+			fmt.Fprintf(&b, `<recording><recordID>rec-%d</recordID></recording>`, i)
+		}
+		fmt.Fprint(&b, `</recordings>`)
+		if paging {
+			fmt.Fprintf(&b, `<totalElements>%d</totalElements>`, n)
+		}
+		fmt.Fprint(&b, `</response>`)
+		w.Write([]byte(b.String()))
+	})
+}
+
+// collect drains an iterator into a list of record IDs.
+func collect(it *bbb.RecordingsIterator) []string {
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Recording().RecordID)
+	}
+	return ids
+}
+
+// -------------------- RecordingsIterator --------------------
+
+func TestRecordingsIterator_Pages(t *testing.T) {
+	var calls atomic.Int32
+	client := recordingsServer(t, 25, true, &calls)
+
+	it := client.IterateRecordings(context.Background(), &requests.GetRecordingsRequest{Limit: 10})
+	assert.Zero(t, it.Total())
+
+	ids := collect(it)
+	require.NoError(t, it.Err())
+	require.Len(t, ids, 25)
+	assert.Equal(t, "rec-0", ids[0])
+	assert.Equal(t, "rec-24", ids[24])
+	assert.Equal(t, 25, it.Total())
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestRecordingsIterator_ExactPages(t *testing.T) {
+	var calls atomic.Int32
+	client := recordingsServer(t, 20, true, &calls)
+
+	ids := collect(client.IterateRecordings(context.Background(), &requests.GetRecordingsRequest{Limit: 10, Offset: 5}))
+	assert.Len(t, ids, 15)
+	assert.Equal(t, "rec-5", ids[0])
+	assert.Equal(t, int32(2), calls.Load(), "totalElements avoids fetching an empty page")
+}
+
+func TestRecordingsIterator_WithoutPaging(t *testing.T) {
+	var calls atomic.Int32
+	client := recordingsServer(t, 150, false, &calls)
+
+	it := client.IterateRecordings(context.Background(), nil)
+	ids := collect(it)
+	require.NoError(t, it.Err())
+	assert.Len(t, ids, 150)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRecordingsIterator_WithoutPagingFullPage(t *testing.T) {
+	var calls atomic.Int32
+	client := recordingsServer(t, 100, false, &calls)
+
+	ids := collect(client.IterateRecordings(context.Background(), nil))
+	assert.Len(t, ids, 100, "the repeated first page is not returned twice")
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestRecordingsIterator_Empty(t *testing.T) {
+	var calls atomic.Int32
+	client := recordingsServer(t, 0, true, &calls)
+
+	it := client.IterateRecordings(context.Background(), nil)
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Err())
+}
+
+func TestRecordingsIterator_Errors(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	it := client.IterateRecordings(context.Background(), nil)
+	assert.False(t, it.Next())
+	require.Error(t, it.Err())
+	assert.False(t, it.Next())
+
+	var calls atomic.Int32
+	client = recordingsServer(t, 25, true, &calls)
+	ctx, cancel := context.WithCancel(context.Background())
+	it = client.IterateRecordings(ctx, &requests.GetRecordingsRequest{Limit: 10})
+	require.True(t, it.Next())
+	cancel()
+	assert.False(t, it.Next())
+	assert.ErrorIs(t, it.Err(), context.Canceled)
+}
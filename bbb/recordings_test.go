@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/amirazad1/bigbluebutton-api-go/bbb"
 	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
@@ -30,6 +31,11 @@ func TestGetRecordings_Success(t *testing.T) {
 						<state>published</state>
 						<startTime>1234567890</startTime>
 						<endTime>1234568990</endTime>
+						<metadata>
+							<isBreakout>false</isBreakout>
+							<meetingName>Test Meeting</meetingName>
+							<gl-listed>false</gl-listed>
+						</metadata>
 						<playback>
 							<format>
 								<type>presentation</type>
@@ -42,7 +48,7 @@ func TestGetRecordings_Success(t *testing.T) {
 			</response>`))
 	})
 
-	req := &requests.GetRecordingsRequest{MeetingID: "test123"}
+	req := &requests.GetRecordingsRequest{MeetingIDs: []string{"test123"}}
 	resp, err := client.GetRecordings(context.Background(), req)
 	require.NoError(t, err)
 
@@ -51,6 +57,63 @@ func TestGetRecordings_Success(t *testing.T) {
 	assert.Equal(t, "recording-123", resp.Recordings[0].RecordID)
 	assert.Equal(t, "test123", resp.Recordings[0].MeetingID)
 	assert.True(t, resp.Recordings[0].Published)
+	assert.Equal(t, "Test Meeting", resp.Recordings[0].Metadata["meetingName"])
+	assert.Equal(t, "false", resp.Recordings[0].Metadata["gl-listed"])
+	assert.Equal(t, time.UnixMilli(1234567890), resp.Recordings[0].StartTime)
+	require.Len(t, resp.Recordings[0].Playback, 1)
+	assert.Equal(t, "presentation", resp.Recordings[0].Playback[0].Type)
+	assert.Equal(t, 1100, resp.Recordings[0].Playback[0].Length)
+}
+
+func TestGetRecordings_Filters(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "course-1,course-2", q.Get("meetingID"))
+		assert.Equal(t, "abc,def", q.Get("recordID"))
+		assert.Equal(t, "published,unpublished", q.Get("state"))
+		assert.Equal(t, "math-101", q.Get("meta_course"))
+		assert.Equal(t, "true", q.Get("meta_gl-listed"))
+		assert.Equal(t, "20", q.Get("offset"))
+		assert.Equal(t, "50", q.Get("limit"))
+
+		w.Write([]byte(`<response><returncode>SUCCESS</returncode><recordings></recordings></response>`))
+	})
+
+	_, err := client.GetRecordings(context.Background(), &requests.GetRecordingsRequest{
+		MeetingIDs: []string{"course-1", "course-2"},
+		RecordIDs:  []string{"abc", "def"},
+		States:     []requests.RecordingState{requests.RecordingStatePublished, requests.RecordingStateUnpublished},
+		Meta:       map[string]string{"course": "math-101", "gl-listed": "true"},
+		Offset:     20,
+		Limit:      50,
+	})
+	require.NoError(t, err)
+}
+
+func TestGetRecordings_Validation(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request expected")
+	})
+
+	tests := []struct {
+		name string
+		req  *requests.GetRecordingsRequest
+	}{
+		{"empty meetingID", &requests.GetRecordingsRequest{MeetingIDs: []string{"a", ""}}},
+		{"meetingID with comma", &requests.GetRecordingsRequest{MeetingIDs: []string{"a,b"}}},
+		{"recordID with comma", &requests.GetRecordingsRequest{RecordIDs: []string{"a,b"}}},
+		{"invalid state", &requests.GetRecordingsRequest{States: []requests.RecordingState{"archived"}}},
+		{"empty meta key", &requests.GetRecordingsRequest{Meta: map[string]string{"": "x"}}},
+		{"negative offset", &requests.GetRecordingsRequest{Offset: -1}},
+		{"limit too large", &requests.GetRecordingsRequest{Limit: 101}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetRecordings(context.Background(), tt.req)
+			assert.True(t, bbb.IsError(err, bbb.ErrInvalidParam), err)
+		})
+	}
 }
 
 // -------------------- PublishRecordings --------------------
@@ -0,0 +1,108 @@
+/*
+Package requests contains request structures for BigBlueButton API calls.
+This file defines the well-known userdata-bbb_* parameters that customize the HTML5 client on join.
+*/
+
+package requests
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ClientSettings customizes the HTML5 client for a joining user. Each field maps to a
+// userdata-bbb_* join parameter and is only sent when set.
+type ClientSettings struct {
+	// Audio
+	AutoJoinAudio             *bool `json:"bbb_auto_join_audio,omitempty"`
+	ListenOnlyMode            *bool `json:"bbb_listen_only_mode,omitempty"`
+	ForceListenOnly           *bool `json:"bbb_force_listen_only,omitempty"`
+	SkipCheckAudio            *bool `json:"bbb_skip_check_audio,omitempty"`
+	SkipCheckAudioOnFirstJoin *bool `json:"bbb_skip_check_audio_on_first_join,omitempty"`
+	OutsideToggleSelfVoice    *bool `json:"bbb_outside_toggle_self_voice,omitempty"`
+
+	// Video
+	AutoShareWebcam             *bool  `json:"bbb_auto_share_webcam,omitempty"`
+	SkipVideoPreview            *bool  `json:"bbb_skip_video_preview,omitempty"`
+	SkipVideoPreviewOnFirstJoin *bool  `json:"bbb_skip_video_preview_on_first_join,omitempty"`
+	MirrorOwnWebcam             *bool  `json:"bbb_mirror_own_webcam,omitempty"`
+	PreferredCameraProfile      string `json:"bbb_preferred_camera_profile,omitempty"`
+	RecordVideo                 *bool  `json:"bbb_record_video,omitempty"`
+
+	// Presentation and whiteboard
+	ForceRestorePresentationOnNewEvents *bool    `json:"bbb_force_restore_presentation_on_new_events,omitempty"`
+	HidePresentationOnJoin              *bool    `json:"bbb_hide_presentation_on_join,omitempty"`
+	MultiUserPenOnly                    *bool    `json:"bbb_multi_user_pen_only,omitempty"`
+	PresenterTools                      []string `json:"bbb_presenter_tools,omitempty"`
+	MultiUserTools                      []string `json:"bbb_multi_user_tools,omitempty"`
+
+	// Layout and branding
+	AutoSwapLayout          *bool  `json:"bbb_auto_swap_layout,omitempty"`
+	ShowParticipantsOnLogin *bool  `json:"bbb_show_participants_on_login,omitempty"`
+	ShowPublicChatOnLogin   *bool  `json:"bbb_show_public_chat_on_login,omitempty"`
+	HideNavBar              *bool  `json:"bbb_hide_nav_bar,omitempty"`
+	HideActionsBar          *bool  `json:"bbb_hide_actions_bar,omitempty"`
+	DisplayBrandingArea     *bool  `json:"bbb_display_branding_area,omitempty"`
+	ClientTitle             string `json:"bbb_client_title,omitempty"`
+	CustomStyle             string `json:"bbb_custom_style,omitempty"`
+	CustomStyleURL          string `json:"bbb_custom_style_url,omitempty"`
+
+	// Miscellaneous
+	AskForFeedbackOnLogout *bool `json:"bbb_ask_for_feedback_on_logout,omitempty"`
+	OutsideToggleRecording *bool `json:"bbb_outside_toggle_recording,omitempty"`
+}
+
+// UserData returns the settings as userdata keys without the "userdata-" prefix
+func (s ClientSettings) UserData() map[string]string {
+	data := map[string]string{}
+
+	setBool := func(key string, v *bool) {
+		if v != nil {
+			data[key] = strconv.FormatBool(*v)
+		}
+	}
+	setString := func(key, v string) {
+		if v != "" {
+			data[key] = v
+		}
+	}
+
+	setBool("bbb_auto_join_audio", s.AutoJoinAudio)
+	setBool("bbb_listen_only_mode", s.ListenOnlyMode)
+	setBool("bbb_force_listen_only", s.ForceListenOnly)
+	setBool("bbb_skip_check_audio", s.SkipCheckAudio)
+	setBool("bbb_skip_check_audio_on_first_join", s.SkipCheckAudioOnFirstJoin)
+	setBool("bbb_outside_toggle_self_voice", s.OutsideToggleSelfVoice)
+
+	setBool("bbb_auto_share_webcam", s.AutoShareWebcam)
+	setBool("bbb_skip_video_preview", s.SkipVideoPreview)
+	setBool("bbb_skip_video_preview_on_first_join", s.SkipVideoPreviewOnFirstJoin)
+	setBool("bbb_mirror_own_webcam", s.MirrorOwnWebcam)
+	setString("bbb_preferred_camera_profile", s.PreferredCameraProfile)
+	setBool("bbb_record_video", s.RecordVideo)
+
+	setBool("bbb_force_restore_presentation_on_new_events", s.ForceRestorePresentationOnNewEvents)
+	setBool("bbb_hide_presentation_on_join", s.HidePresentationOnJoin)
+	setBool("bbb_multi_user_pen_only", s.MultiUserPenOnly)
+	if len(s.PresenterTools) > 0 {
+		data["bbb_presenter_tools"] = "[" + strings.Join(s.PresenterTools, ",") + "]"
+	}
+	if len(s.MultiUserTools) > 0 {
+		data["bbb_multi_user_tools"] = "[" + strings.Join(s.MultiUserTools, ",") + "]"
+	}
+
+	setBool("bbb_auto_swap_layout", s.AutoSwapLayout)
+	setBool("bbb_show_participants_on_login", s.ShowParticipantsOnLogin)
+	setBool("bbb_show_public_chat_on_login", s.ShowPublicChatOnLogin)
+	setBool("bbb_hide_nav_bar", s.HideNavBar)
+	setBool("bbb_hide_actions_bar", s.HideActionsBar)
+	setBool("bbb_display_branding_area", s.DisplayBrandingArea)
+	setString("bbb_client_title", s.ClientTitle)
+	setString("bbb_custom_style", s.CustomStyle)
+	setString("bbb_custom_style_url", s.CustomStyleURL)
+
+	setBool("bbb_ask_for_feedback_on_logout", s.AskForFeedbackOnLogout)
+	setBool("bbb_outside_toggle_recording", s.OutsideToggleRecording)
+
+	return data
+}
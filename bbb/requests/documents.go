@@ -0,0 +1,55 @@
+/*
+Package requests contains request structures for BigBlueButton API calls.
+This file defines the document type used to upload presentations when creating a meeting
+or inserting documents into a running one.
+*/
+
+package requests
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Document describes a presentation to upload, either fetched by the server
+// from URL or sent inline from Content. The content is held in memory so that
+// the same request can be sent more than once, e.g. when retrying
+type Document struct {
+	URL          string `json:"url,omitempty"`
+	Filename     string `json:"filename,omitempty"` // Required for inline content
+	Content      []byte `json:"-"`                  // Sent base64 encoded when URL is empty
+	Current      *bool  `json:"current,omitempty"`
+	Downloadable *bool  `json:"downloadable,omitempty"`
+	Removable    *bool  `json:"removable,omitempty"`
+}
+
+// DocumentFromURL returns a document the server downloads from url
+func DocumentFromURL(url, filename string) Document {
+	return Document{URL: url, Filename: filename}
+}
+
+// DocumentFromReader returns a document uploaded inline with the content read from r
+func DocumentFromReader(filename string, r io.Reader) (Document, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Document{}, fmt.Errorf("reading document: %w", err)
+	}
+	return Document{Filename: filename, Content: data}, nil
+}
+
+// DocumentFromFile returns a document uploaded inline with the content of the file at path
+func DocumentFromFile(path string) (Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Document{}, fmt.Errorf("reading document: %w", err)
+	}
+	return Document{Filename: filepath.Base(path), Content: data}, nil
+}
+
+// InsertDocumentRequest represents the parameters for adding documents to a running meeting
+type InsertDocumentRequest struct {
+	MeetingID string     `json:"meetingID"`
+	Documents []Document `json:"documents"`
+}
@@ -5,45 +5,255 @@ This file defines the request types for meeting-related API operations such as c
 
 package requests
 
-// CreateMeetingRequest represents the parameters for creating a new meeting
+// CreateMeetingRequest represents the parameters for creating a new meeting.
+// Optional boolean and integer fields are pointers: leave them nil to keep the
+// server default, or set them with Bool and Int.
 type CreateMeetingRequest struct {
-	Name                               string            `json:"name"`
-	MeetingID                          string            `json:"meetingID"`
-	AttendeePW                         string            `json:"attendeePW"`
-	ModeratorPW                        string            `json:"moderatorPW"`
-	Welcome                            string            `json:"welcome,omitempty"`
-	DialNumber                         string            `json:"dialNumber,omitempty"`
-	VoiceBridge                        string            `json:"voiceBridge,omitempty"`
-	WebVoice                           string            `json:"webVoice,omitempty"`
-	LogoutURL                          string            `json:"logoutURL,omitempty"`
-	MaxParticipants                    int               `json:"maxParticipants,omitempty"`
-	Record                             bool              `json:"record,omitempty"`
-	AutoStartRecording                 bool              `json:"autoStartRecording,omitempty"`
-	AllowStartStopRecording            bool              `json:"allowStartStopRecording,omitempty"`
-	WebcamsOnlyForModerator            bool              `json:"webcamsOnlyForModerator,omitempty"`
-	MuteOnStart                        bool              `json:"muteOnStart,omitempty"`
-	LockSettingsDisableCam             bool              `json:"lockSettingsDisableCam,omitempty"`
-	LockSettingsDisableMic             bool              `json:"lockSettingsDisableMic,omitempty"`
-	LockSettingsDisablePrivateChat     bool              `json:"lockSettingsDisablePrivateChat,omitempty"`
-	LockSettingsDisablePublicChat      bool              `json:"lockSettingsDisablePublicChat,omitempty"`
-	LockSettingsLockedLayout           bool              `json:"lockSettingsLockedLayout,omitempty"`
-	LockSettingsLockOnJoin             bool              `json:"lockSettingsLockOnJoin,omitempty"`
-	LockSettingsLockOnJoinConfigurable bool              `json:"lockSettingsLockOnJoinConfigurable,omitempty"`
-	Meta                               map[string]string `json:"meta,omitempty"`
+	Name                                   string            `json:"name"`
+	MeetingID                              string            `json:"meetingID"`
+	AttendeePW                             string            `json:"attendeePW,omitempty"`  // Deprecated: join with a Role instead
+	ModeratorPW                            string            `json:"moderatorPW,omitempty"` // Deprecated: join with a Role instead
+	Welcome                                string            `json:"welcome,omitempty"`
+	DialNumber                             string            `json:"dialNumber,omitempty"`
+	VoiceBridge                            string            `json:"voiceBridge,omitempty"`
+	WebVoice                               string            `json:"webVoice,omitempty"`
+	LogoutURL                              string            `json:"logoutURL,omitempty"`
+	MaxParticipants                        *int              `json:"maxParticipants,omitempty"`
+	Duration                               *int              `json:"duration,omitempty"` // Minutes; 0 means no limit
+	Record                                 *bool             `json:"record,omitempty"`
+	AutoStartRecording                     *bool             `json:"autoStartRecording,omitempty"`
+	AllowStartStopRecording                *bool             `json:"allowStartStopRecording,omitempty"`
+	RecordFullDurationMedia                *bool             `json:"recordFullDurationMedia,omitempty"`
+	NotifyRecordingIsOn                    *bool             `json:"notifyRecordingIsOn,omitempty"`
+	WebcamsOnlyForModerator                *bool             `json:"webcamsOnlyForModerator,omitempty"`
+	MuteOnStart                            *bool             `json:"muteOnStart,omitempty"`
+	AllowModsToUnmuteUsers                 *bool             `json:"allowModsToUnmuteUsers,omitempty"`
+	AllowModsToEjectCameras                *bool             `json:"allowModsToEjectCameras,omitempty"`
+	AllowRequestsWithoutSession            *bool             `json:"allowRequestsWithoutSession,omitempty"`
+	MeetingCameraCap                       *int              `json:"meetingCameraCap,omitempty"`
+	UserCameraCap                          *int              `json:"userCameraCap,omitempty"`
+	LockSettingsDisableCam                 *bool             `json:"lockSettingsDisableCam,omitempty"`
+	LockSettingsDisableMic                 *bool             `json:"lockSettingsDisableMic,omitempty"`
+	LockSettingsDisablePrivateChat         *bool             `json:"lockSettingsDisablePrivateChat,omitempty"`
+	LockSettingsDisablePublicChat          *bool             `json:"lockSettingsDisablePublicChat,omitempty"`
+	LockSettingsDisableNotes               *bool             `json:"lockSettingsDisableNotes,omitempty"`
+	LockSettingsHideUserList               *bool             `json:"lockSettingsHideUserList,omitempty"`
+	LockSettingsHideViewersCursor          *bool             `json:"lockSettingsHideViewersCursor,omitempty"`
+	LockSettingsHideViewersAnnotation      *bool             `json:"lockSettingsHideViewersAnnotation,omitempty"`
+	LockSettingsLockedLayout               *bool             `json:"lockSettingsLockedLayout,omitempty"`
+	LockSettingsLockOnJoin                 *bool             `json:"lockSettingsLockOnJoin,omitempty"`
+	LockSettingsLockOnJoinConfigurable     *bool             `json:"lockSettingsLockOnJoinConfigurable,omitempty"`
+	IsBreakout                             *bool             `json:"isBreakout,omitempty"`
+	ParentMeetingID                        string            `json:"parentMeetingID,omitempty"` // Required when IsBreakout is set
+	Sequence                               int               `json:"sequence,omitempty"`        // Required when IsBreakout is set
+	FreeJoin                               *bool             `json:"freeJoin,omitempty"`
+	BreakoutRoomsEnabled                   *bool             `json:"breakoutRoomsEnabled,omitempty"` // Deprecated: use DisabledFeatures
+	BreakoutRoomsPrivateChatEnabled        *bool             `json:"breakoutRoomsPrivateChatEnabled,omitempty"`
+	BreakoutRoomsRecord                    *bool             `json:"breakoutRoomsRecord,omitempty"`
+	Groups                                 []BreakoutGroup   `json:"groups,omitempty"`
+	ModeratorOnlyMessage                   string            `json:"moderatorOnlyMessage,omitempty"`
+	BannerText                             string            `json:"bannerText,omitempty"`
+	BannerColor                            string            `json:"bannerColor,omitempty"` // e.g. "#FF0000"
+	Logo                                   string            `json:"logo,omitempty"`
+	GuestPolicy                            GuestPolicy       `json:"guestPolicy,omitempty"`
+	MeetingLayout                          MeetingLayout     `json:"meetingLayout,omitempty"`
+	MeetingKeepEvents                      *bool             `json:"meetingKeepEvents,omitempty"`
+	EndWhenNoModerator                     *bool             `json:"endWhenNoModerator,omitempty"`
+	EndWhenNoModeratorDelayInMinutes       *int              `json:"endWhenNoModeratorDelayInMinutes,omitempty"`
+	MeetingExpireIfNoUserJoinedInMinutes   *int              `json:"meetingExpireIfNoUserJoinedInMinutes,omitempty"`
+	MeetingExpireWhenLastUserLeftInMinutes *int              `json:"meetingExpireWhenLastUserLeftInMinutes,omitempty"`
+	LearningDashboardEnabled               *bool             `json:"learningDashboardEnabled,omitempty"` // Deprecated: use DisabledFeatures
+	LearningDashboardCleanupDelayInMinutes *int              `json:"learningDashboardCleanupDelayInMinutes,omitempty"`
+	DisabledFeatures                       []DisabledFeature `json:"disabledFeatures,omitempty"`
+	DisabledFeaturesExclude                []DisabledFeature `json:"disabledFeaturesExclude,omitempty"`
+	PreUploadedPresentation                string            `json:"preUploadedPresentation,omitempty"`
+	PreUploadedPresentationName            string            `json:"preUploadedPresentationName,omitempty"`
+	PreUploadedPresentationOverrideDefault *bool             `json:"preUploadedPresentationOverrideDefault,omitempty"`
+	PresentationUploadExternalURL          string            `json:"presentationUploadExternalUrl,omitempty"`
+	PresentationUploadExternalDescription  string            `json:"presentationUploadExternalDescription,omitempty"`
+	PresentationConversionCacheEnabled     *bool             `json:"presentationConversionCacheEnabled,omitempty"`
+	MaxNumPages                            *int              `json:"maxNumPages,omitempty"`
+	PluginManifests                        []PluginManifest  `json:"pluginManifests,omitempty"`
+	PluginManifestsFetchURL                string            `json:"pluginManifestsFetchUrl,omitempty"`
+	Presentations                          []Document        `json:"presentations,omitempty"` // Uploaded in a POST body
+	Meta                                   map[string]string `json:"meta,omitempty"`
+}
+
+// BreakoutGroup pre-assigns users to a breakout room of the meeting
+type BreakoutGroup struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name,omitempty"`
+	Roster []string `json:"roster"` // External user IDs
+}
+
+// PluginManifest describes a client plugin loaded into the meeting (BigBlueButton 3.0+)
+type PluginManifest struct {
+	URL      string `json:"url"`
+	Checksum string `json:"checksum,omitempty"`
+}
+
+// GuestPolicy controls how guests are admitted to a meeting
+type GuestPolicy string
+
+// Guest policies accepted by the create API
+const (
+	GuestPolicyAlwaysAccept GuestPolicy = "ALWAYS_ACCEPT"
+	GuestPolicyAlwaysDeny   GuestPolicy = "ALWAYS_DENY"
+	GuestPolicyAskModerator GuestPolicy = "ASK_MODERATOR"
+)
+
+// Valid reports whether the guest policy is known to BigBlueButton
+func (p GuestPolicy) Valid() bool {
+	switch p {
+	case GuestPolicyAlwaysAccept, GuestPolicyAlwaysDeny, GuestPolicyAskModerator:
+		return true
+	}
+	return false
+}
+
+// MeetingLayout is the layout applied when users join a meeting
+type MeetingLayout string
+
+// Meeting layouts accepted by the create API
+const (
+	LayoutCustom               MeetingLayout = "CUSTOM_LAYOUT"
+	LayoutSmart                MeetingLayout = "SMART_LAYOUT"
+	LayoutPresentationFocus    MeetingLayout = "PRESENTATION_FOCUS"
+	LayoutVideoFocus           MeetingLayout = "VIDEO_FOCUS"
+	LayoutCamerasOnly          MeetingLayout = "CAMERAS_ONLY"
+	LayoutParticipantsChatOnly MeetingLayout = "PARTICIPANTS_AND_CHAT_ONLY"
+	LayoutPresentationOnly     MeetingLayout = "PRESENTATION_ONLY"
+	LayoutMediaOnly            MeetingLayout = "MEDIA_ONLY"
+)
+
+// Valid reports whether the layout is known to BigBlueButton
+func (l MeetingLayout) Valid() bool {
+	switch l {
+	case LayoutCustom, LayoutSmart, LayoutPresentationFocus, LayoutVideoFocus,
+		LayoutCamerasOnly, LayoutParticipantsChatOnly, LayoutPresentationOnly, LayoutMediaOnly:
+		return true
+	}
+	return false
+}
+
+// DisabledFeature is a client feature that can be turned off for a meeting
+type DisabledFeature string
+
+// Features accepted by the disabledFeatures parameter
+const (
+	FeatureBreakoutRooms                        DisabledFeature = "breakoutRooms"
+	FeatureCaptions                             DisabledFeature = "captions"
+	FeatureChat                                 DisabledFeature = "chat"
+	FeaturePrivateChat                          DisabledFeature = "privateChat"
+	FeatureDeleteChatMessage                    DisabledFeature = "deleteChatMessage"
+	FeatureEditChatMessage                      DisabledFeature = "editChatMessage"
+	FeatureReplyChatMessage                     DisabledFeature = "replyChatMessage"
+	FeatureChatMessageReactions                 DisabledFeature = "chatMessageReactions"
+	FeatureDownloadPresentationWithAnnotations  DisabledFeature = "downloadPresentationWithAnnotations"
+	FeatureDownloadPresentationConvertedToPdf   DisabledFeature = "downloadPresentationConvertedToPdf"
+	FeatureDownloadPresentationOriginalFile     DisabledFeature = "downloadPresentationOriginalFile"
+	FeatureExternalVideos                       DisabledFeature = "externalVideos"
+	FeatureImportPresentationFromBreakoutRooms  DisabledFeature = "importPresentationWithAnnotationsFromBreakoutRooms"
+	FeatureImportSharedNotesFromBreakoutRooms   DisabledFeature = "importSharedNotesFromBreakoutRooms"
+	FeatureLayouts                              DisabledFeature = "layouts"
+	FeatureLearningDashboard                    DisabledFeature = "learningDashboard"
+	FeatureLearningDashboardDownloadSessionData DisabledFeature = "learningDashboardDownloadSessionData"
+	FeaturePolls                                DisabledFeature = "polls"
+	FeatureScreenshare                          DisabledFeature = "screenshare"
+	FeatureSharedNotes                          DisabledFeature = "sharedNotes"
+	FeatureVirtualBackgrounds                   DisabledFeature = "virtualBackgrounds"
+	FeatureCustomVirtualBackgrounds             DisabledFeature = "customVirtualBackgrounds"
+	FeatureLiveTranscription                    DisabledFeature = "liveTranscription"
+	FeaturePresentation                         DisabledFeature = "presentation"
+	FeatureCameraAsContent                      DisabledFeature = "cameraAsContent"
+	FeatureSnapshotOfCurrentSlide               DisabledFeature = "snapshotOfCurrentSlide"
+	FeatureTimer                                DisabledFeature = "timer"
+	FeatureInfiniteWhiteboard                   DisabledFeature = "infiniteWhiteboard"
+	FeatureRaiseHand                            DisabledFeature = "raiseHand"
+	FeatureUserReactions                        DisabledFeature = "userReactions"
+	FeatureChatEmojiPicker                      DisabledFeature = "chatEmojiPicker"
+	FeatureQuizzes                              DisabledFeature = "quizzes"
+)
+
+// knownFeatures lists every feature accepted by the disabledFeatures parameter
+var knownFeatures = map[DisabledFeature]bool{
+	FeatureBreakoutRooms:                        true,
+	FeatureCaptions:                             true,
+	FeatureChat:                                 true,
+	FeaturePrivateChat:                          true,
+	FeatureDeleteChatMessage:                    true,
+	FeatureEditChatMessage:                      true,
+	FeatureReplyChatMessage:                     true,
+	FeatureChatMessageReactions:                 true,
+	FeatureDownloadPresentationWithAnnotations:  true,
+	FeatureDownloadPresentationConvertedToPdf:   true,
+	FeatureDownloadPresentationOriginalFile:     true,
+	FeatureExternalVideos:                       true,
+	FeatureImportPresentationFromBreakoutRooms:  true,
+	FeatureImportSharedNotesFromBreakoutRooms:   true,
+	FeatureLayouts:                              true,
+	FeatureLearningDashboard:                    true,
+	FeatureLearningDashboardDownloadSessionData: true,
+	FeaturePolls:                                true,
+	FeatureScreenshare:                          true,
+	FeatureSharedNotes:                          true,
+	FeatureVirtualBackgrounds:                   true,
+	FeatureCustomVirtualBackgrounds:             true,
+	FeatureLiveTranscription:                    true,
+	FeaturePresentation:                         true,
+	FeatureCameraAsContent:                      true,
+	FeatureSnapshotOfCurrentSlide:               true,
+	FeatureTimer:                                true,
+	FeatureInfiniteWhiteboard:                   true,
+	FeatureRaiseHand:                            true,
+	FeatureUserReactions:                        true,
+	FeatureChatEmojiPicker:                      true,
+	FeatureQuizzes:                              true,
+}
+
+// Valid reports whether the feature is known to BigBlueButton
+func (f DisabledFeature) Valid() bool {
+	return knownFeatures[f]
 }
 
 // JoinMeetingRequest represents the parameters for joining a meeting
 type JoinMeetingRequest struct {
-	FullName   string            `json:"fullName"`
-	MeetingID  string            `json:"meetingID"`
-	Password   string            `json:"password"`
-	UserID     string            `json:"userId,omitempty"`
-	CreateTime string            `json:"createTime,omitempty"`
-	UserData   map[string]string `json:"userData,omitempty"`
+	FullName             string            `json:"fullName"`
+	MeetingID            string            `json:"meetingID"`
+	Password             string            `json:"password,omitempty"` // Deprecated: use Role
+	Role                 Role              `json:"role,omitempty"`     // Either Role or Password is required
+	UserID               string            `json:"userId,omitempty"`
+	CreateTime           string            `json:"createTime,omitempty"`
+	AvatarURL            string            `json:"avatarURL,omitempty"`
+	WebcamBackgroundURL  string            `json:"webcamBackgroundURL,omitempty"`
+	Guest                *bool             `json:"guest,omitempty"`
+	Bot                  *bool             `json:"bot,omitempty"`
+	ExcludeFromDashboard *bool             `json:"excludeFromDashboard,omitempty"`
+	Redirect             *bool             `json:"redirect,omitempty"`
+	ErrorRedirectURL     string            `json:"errorRedirectUrl,omitempty"`
+	LogoutURL            string            `json:"logoutURL,omitempty"`
+	ConfigToken          string            `json:"configToken,omitempty"`
+	DefaultLayout        MeetingLayout     `json:"defaultLayout,omitempty"`
+	ClientSettings       *ClientSettings   `json:"clientSettings,omitempty"` // Sent as userdata-bbb_* parameters
+	UserData             map[string]string `json:"userData,omitempty"`       // Sent as userdata-<key> parameters
+}
+
+// Role is the role a user joins a meeting with
+type Role string
+
+// Roles accepted by the join API
+const (
+	RoleModerator Role = "MODERATOR"
+	RoleViewer    Role = "VIEWER"
+)
+
+// Valid reports whether the role is known to BigBlueButton
+func (r Role) Valid() bool {
+	return r == RoleModerator || r == RoleViewer
 }
 
 // EndMeetingRequest represents the parameters for ending a meeting
 type EndMeetingRequest struct {
 	MeetingID string `json:"meetingID"`
-	Password  string `json:"password"`
+	Password  string `json:"password,omitempty"` // Optional since BigBlueButton 2.4
 }
@@ -0,0 +1,17 @@
+/*
+Package requests contains request structures for BigBlueButton API calls.
+This file defines helpers for optional request fields. A nil pointer means the
+parameter is not sent, so the server default from bigbluebutton.properties applies.
+*/
+
+package requests
+
+// Bool returns a pointer to v, for setting optional boolean fields
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Int returns a pointer to v, for setting optional integer fields
+func Int(v int) *int {
+	return &v
+}
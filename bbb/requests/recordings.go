@@ -5,14 +5,41 @@ This file defines the request types for recordings-related API operations such a
 
 package requests
 
-// GetRecordingsRequest represents the parameters for getting recordings
+import "io"
+
+// RecordingState is the processing state of a recording
+type RecordingState string
+
+// Recording states accepted by the getRecordings state filter
+const (
+	RecordingStateAny         RecordingState = "any" // Every state except deleted
+	RecordingStateProcessing  RecordingState = "processing"
+	RecordingStateProcessed   RecordingState = "processed"
+	RecordingStatePublished   RecordingState = "published"
+	RecordingStateUnpublished RecordingState = "unpublished"
+	RecordingStateDeleted     RecordingState = "deleted"
+)
+
+// Valid reports whether the state is accepted by BigBlueButton
+func (s RecordingState) Valid() bool {
+	switch s {
+	case RecordingStateAny, RecordingStateProcessing, RecordingStateProcessed,
+		RecordingStatePublished, RecordingStateUnpublished, RecordingStateDeleted:
+		return true
+	}
+	return false
+}
+
+// GetRecordingsRequest represents the parameters for getting recordings.
+// Recordings match when they belong to any of MeetingIDs, have any of RecordIDs
+// and are in any of States; without States, published and unpublished recordings match
 type GetRecordingsRequest struct {
-	MeetingID string `json:"meetingID,omitempty"`
-	RecordID  string `json:"recordID,omitempty"`
-	State     string `json:"state,omitempty"` // "any", "processing", "processed", "published", "unpublished", "deleted"
-	Meta      string `json:"meta,omitempty"`  // A meta parameter to filter by
-	Offset    int    `json:"offset,omitempty"`
-	Limit     int    `json:"limit,omitempty"`
+	MeetingIDs []string          `json:"meetingID,omitempty"`
+	RecordIDs  []string          `json:"recordID,omitempty"` // Full IDs or prefixes
+	States     []RecordingState  `json:"state,omitempty"`
+	Meta       map[string]string `json:"meta,omitempty"` // Sent as meta_<key> filters that must all match
+	Offset     int               `json:"offset,omitempty"`
+	Limit      int               `json:"limit,omitempty"` // At most 100
 }
 
 // PublishRecordingsRequest represents the parameters for publishing/unpublishing recordings
@@ -31,3 +58,27 @@ type UpdateRecordingsRequest struct {
 	RecordID string            `json:"recordID"`
 	Meta     map[string]string `json:"meta,omitempty"`
 }
+
+// TextTrackKind is the kind of a recording text track
+type TextTrackKind string
+
+// Text track kinds accepted by putRecordingTextTrack
+const (
+	TextTrackSubtitles TextTrackKind = "subtitles" // Transcription of the dialogue
+	TextTrackCaptions  TextTrackKind = "captions"  // Transcription including sound effects, for deaf viewers
+)
+
+// Valid reports whether the kind is accepted by BigBlueButton
+func (k TextTrackKind) Valid() bool {
+	return k == TextTrackSubtitles || k == TextTrackCaptions
+}
+
+// PutRecordingTextTrackRequest represents the parameters for uploading a text track to a recording
+type PutRecordingTextTrackRequest struct {
+	RecordID string        `json:"recordID"` // Required
+	Kind     TextTrackKind `json:"kind"`     // Required
+	Lang     string        `json:"lang"`     // Required, BCP 47 language tag such as "en-US"
+	Label    string        `json:"label,omitempty"`
+	Filename string        `json:"-"` // Name of the uploaded file, e.g. "captions.vtt"
+	File     io.Reader     `json:"-"` // WebVTT or SRT content; omit to remove the track
+}
@@ -10,9 +10,20 @@ type CreateHookRequest struct {
 	CallbackURL string            `json:"callbackURL"`         // Required
 	MeetingID   string            `json:"meetingID,omitempty"` // If empty, creates a permanent hook
 	GetRaw      bool              `json:"getRaw,omitempty"`    // Return raw recording format
+	EventIDs    []string          `json:"eventID,omitempty"`   // Only send these events; empty for all events
 	Meta        map[string]string `json:"meta,omitempty"`      // Additional metadata
 }
 
+// UpdateHookRequest represents the parameters for updating a webhook.
+// Empty fields keep their current value.
+type UpdateHookRequest struct {
+	HookID      string            `json:"hookID"` // Required
+	CallbackURL string            `json:"callbackURL,omitempty"`
+	EventIDs    []string          `json:"eventID,omitempty"` // Replaces the event filter
+	GetRaw      *bool             `json:"getRaw,omitempty"`
+	Meta        map[string]string `json:"meta,omitempty"`
+}
+
 // HooksRequest represents common parameters for hook operations
 type HooksRequest struct {
 	HookID    string `json:"hookID,omitempty"`    // Required for all operations except create
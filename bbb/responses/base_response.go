@@ -3,14 +3,15 @@ package responses
 // BaseResponse defines the common interface for all API responses
 type BaseResponse interface {
 	GetReturnCode() string
+	GetMessageKey() string
 	GetMessage() string
 }
 
 // BaseResponseImpl provides a base implementation of BaseResponse
 type BaseResponseImpl struct {
-	ReturnCode string `xml:"returncode"`
-	MessageKey string `xml:"messageKey,omitempty"`
-	Message    string `xml:"message,omitempty"`
+	ReturnCode string `xml:"returncode" json:"returncode"`
+	MessageKey string `xml:"messageKey,omitempty" json:"messageKey,omitempty"`
+	Message    string `xml:"message,omitempty" json:"message,omitempty"`
 }
 
 // GetReturnCode returns the return code from the response
@@ -18,6 +19,11 @@ func (r *BaseResponseImpl) GetReturnCode() string {
 	return r.ReturnCode
 }
 
+// GetMessageKey returns the message key from the response
+func (r *BaseResponseImpl) GetMessageKey() string {
+	return r.MessageKey
+}
+
 // GetMessage returns the message from the response
 func (r *BaseResponseImpl) GetMessage() string {
 	if r.Message != "" {
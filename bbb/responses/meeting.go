@@ -5,6 +5,8 @@ This file defines the response types for meeting-related API operations such as
 
 package responses
 
+import "encoding/xml"
+
 // CreateMeetingResponse represents the response from the create meeting API
 type CreateMeetingResponse struct {
 	BaseResponseImpl
@@ -32,59 +34,115 @@ type JoinMeetingResponse struct {
 	URL          string `xml:"url"`
 }
 
+// Guest statuses returned by the join API
+const (
+	GuestStatusAllow = "ALLOW"
+	GuestStatusDeny  = "DENY"
+	GuestStatusWait  = "WAIT"
+)
+
+// IsWaitingForApproval reports whether the user is held in the guest lobby
+func (r *JoinMeetingResponse) IsWaitingForApproval() bool {
+	return r.GuestStatus == GuestStatusWait
+}
+
+// GetJoinURLResponse represents the response from the getJoinUrl API
+type GetJoinURLResponse struct {
+	BaseResponseImpl
+	URL string `xml:"url"`
+}
+
+// EnterResponse represents the session details returned by the enter API
+type EnterResponse struct {
+	BaseResponseImpl
+	FullName           string     `json:"fullname"`
+	ConferenceName     string     `json:"confname"`
+	MeetingID          string     `json:"meetingID"`
+	ExternalMeetingID  string     `json:"externMeetingID"`
+	ExternalUserID     string     `json:"externUserID"`
+	InternalUserID     string     `json:"internalUserID"`
+	AuthToken          string     `json:"authToken"`
+	Role               string     `json:"role"`
+	Guest              string     `json:"guest"`
+	GuestStatus        string     `json:"guestStatus"`
+	Conference         string     `json:"conference"`
+	Room               string     `json:"room"`
+	VoiceBridge        string     `json:"voicebridge"`
+	DialNumber         string     `json:"dialnumber"`
+	WebVoiceConference string     `json:"webvoiceconf"`
+	Mode               string     `json:"mode"`
+	Record             string     `json:"record"`
+	IsBreakout         bool       `json:"isBreakout"`
+	LogoutTimer        int        `json:"logoutTimer"`
+	Welcome            string     `json:"welcome"`
+	LogoutURL          string     `json:"logoutUrl"`
+	DefaultLayout      string     `json:"defaultLayout"`
+	AvatarURL          string     `json:"avatarURL"`
+	Metadata           Metadata   `json:"metadata"`
+	CustomData         CustomData `json:"customdata"`
+}
+
 // EndMeetingResponse represents the response from the end meeting API
 type EndMeetingResponse struct {
 	BaseResponseImpl
 }
 
+// InsertDocumentResponse represents the response from the insertDocument API
+type InsertDocumentResponse struct {
+	BaseResponseImpl
+}
+
 // GetMeetingInfoResponse represents the response from the get meeting info API
 type GetMeetingInfoResponse struct {
 	BaseResponseImpl
-	MeetingName           string            `xml:"meetingName"`
-	MeetingID             string            `xml:"meetingID"`
-	InternalID            string            `xml:"internalMeetingID"`
-	CreateTime            string            `xml:"createTime"`
-	CreateDate            string            `xml:"createDate"`
-	VoiceBridge           string            `xml:"voiceBridge"`
-	DialNumber            string            `xml:"dialNumber"`
-	AttendeePW            string            `xml:"attendeePW"`
-	ModeratorPW           string            `xml:"moderatorPW"`
-	Running               bool              `xml:"running"`
-	Recording             bool              `xml:"recording"`
-	HasBeenForciblyEnded  bool              `xml:"hasBeenForciblyEnded"`
-	StartTime             string            `xml:"startTime"`
-	EndTime               string            `xml:"endTime"`
-	ParticipantCount      int               `xml:"participantCount"`
-	ListenerCount         int               `xml:"listenerCount"`
-	VoiceParticipantCount int               `xml:"voiceParticipantCount"`
-	VideoCount            int               `xml:"videoCount"`
-	Duration              int               `xml:"duration"`
-	HasUserJoined         bool              `xml:"hasUserJoined"`
-	Metadata              map[string]string `xml:"metadata"`
-	ModeratorCount        int               `xml:"moderatorCount"`
+	MeetingName           string     `xml:"meetingName"`
+	MeetingID             string     `xml:"meetingID"`
+	InternalID            string     `xml:"internalMeetingID"`
+	CreateTime            string     `xml:"createTime"`
+	CreateDate            string     `xml:"createDate"`
+	VoiceBridge           string     `xml:"voiceBridge"`
+	DialNumber            string     `xml:"dialNumber"`
+	AttendeePW            string     `xml:"attendeePW"`
+	ModeratorPW           string     `xml:"moderatorPW"`
+	Running               bool       `xml:"running"`
+	Recording             bool       `xml:"recording"`
+	HasBeenForciblyEnded  bool       `xml:"hasBeenForciblyEnded"`
+	StartTime             string     `xml:"startTime"`
+	EndTime               string     `xml:"endTime"`
+	ParticipantCount      int        `xml:"participantCount"`
+	ListenerCount         int        `xml:"listenerCount"`
+	VoiceParticipantCount int        `xml:"voiceParticipantCount"`
+	VideoCount            int        `xml:"videoCount"`
+	Duration              int        `xml:"duration"`
+	HasUserJoined         bool       `xml:"hasUserJoined"`
+	Metadata              Metadata   `xml:"metadata"`
+	ModeratorCount        int        `xml:"moderatorCount"`
+	Attendees             []Attendee `xml:"attendees>attendee"`
 }
 
 // Meeting represents a meeting in the getMeetings response
 type Meeting struct {
-	MeetingID             string            `xml:"meetingID"`
-	MeetingName           string            `xml:"meetingName"`
-	CreateTime            int64             `xml:"createTime"`
-	VoiceBridge           string            `xml:"voiceBridge"`
-	DialNumber            string            `xml:"dialNumber"`
-	AttendeePW            string            `xml:"attendeePW"`
-	ModeratorPW           string            `xml:"moderatorPW"`
-	HasUserJoined         bool              `xml:"hasUserJoined"`
-	HasBeenForciblyEnded  bool              `xml:"hasBeenForciblyEnded"`
-	Running               bool              `xml:"running"`
-	ParticipantCount      int               `xml:"participantCount"`
-	ListenerCount         int               `xml:"listenerCount"`
-	VoiceParticipantCount int               `xml:"voiceParticipantCount"`
-	VideoCount            int               `xml:"videoCount"`
-	Duration              int               `xml:"duration"`
-	CreateDate            string            `xml:"createDate"`
-	StartTime             string            `xml:"startTime"`
-	EndTime               string            `xml:"endTime"`
-	Metadata              map[string]string `xml:"metadata"`
+	MeetingID             string     `xml:"meetingID"`
+	MeetingName           string     `xml:"meetingName"`
+	CreateTime            int64      `xml:"createTime"`
+	VoiceBridge           string     `xml:"voiceBridge"`
+	DialNumber            string     `xml:"dialNumber"`
+	AttendeePW            string     `xml:"attendeePW"`
+	ModeratorPW           string     `xml:"moderatorPW"`
+	HasUserJoined         bool       `xml:"hasUserJoined"`
+	HasBeenForciblyEnded  bool       `xml:"hasBeenForciblyEnded"`
+	Running               bool       `xml:"running"`
+	ParticipantCount      int        `xml:"participantCount"`
+	ListenerCount         int        `xml:"listenerCount"`
+	VoiceParticipantCount int        `xml:"voiceParticipantCount"`
+	VideoCount            int        `xml:"videoCount"`
+	Duration              int        `xml:"duration"`
+	CreateDate            string     `xml:"createDate"`
+	StartTime             string     `xml:"startTime"`
+	EndTime               string     `xml:"endTime"`
+	Metadata              Metadata   `xml:"metadata"`
+	ModeratorCount        int        `xml:"moderatorCount"`
+	Attendees             []Attendee `xml:"attendees>attendee"`
 }
 
 // GetMeetingsResponse represents the response from the getMeetings API
@@ -92,3 +150,45 @@ type GetMeetingsResponse struct {
 	BaseResponseImpl
 	Meetings []Meeting `xml:"meetings>meeting"`
 }
+
+// Attendee represents a user currently in a meeting
+type Attendee struct {
+	UserID          string     `xml:"userID"`
+	FullName        string     `xml:"fullName"`
+	Role            string     `xml:"role"` // "MODERATOR" or "VIEWER"
+	IsPresenter     bool       `xml:"isPresenter"`
+	IsListeningOnly bool       `xml:"isListeningOnly"`
+	HasJoinedVoice  bool       `xml:"hasJoinedVoice"`
+	HasVideo        bool       `xml:"hasVideo"`
+	ClientType      string     `xml:"clientType"`
+	CustomData      CustomData `xml:"customdata"`
+}
+
+// IsModerator reports whether the attendee joined as a moderator
+func (a Attendee) IsModerator() bool {
+	return a.Role == "MODERATOR"
+}
+
+// CustomData holds the userdata-* parameters passed when the attendee joined,
+// keyed by name without the "userdata-" prefix
+type CustomData map[string]string
+
+// UnmarshalXML decodes each child element into a key/value pair
+func (c *CustomData) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	values, err := decodeKeyValues(d)
+	if err != nil {
+		return err
+	}
+	*c = values
+	return nil
+}
+
+// UnmarshalJSON decodes the list of single-key objects sent by the enter API
+func (c *CustomData) UnmarshalJSON(data []byte) error {
+	values, err := decodeJSONKeyValues(data)
+	if err != nil {
+		return err
+	}
+	*c = values
+	return nil
+}
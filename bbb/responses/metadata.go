@@ -0,0 +1,117 @@
+/*
+Package responses contains response structures for BigBlueButton API calls.
+This file defines the metadata type shared by meeting, recording and webhook responses.
+*/
+
+package responses
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"sort"
+)
+
+// Metadata holds the meta_* parameters attached to a meeting, recording or hook.
+// BigBlueButton returns them as arbitrary child elements, e.g.
+// <metadata><course>math-101</course></metadata>, which encoding/xml cannot
+// decode into a map on its own.
+type Metadata map[string]string
+
+// UnmarshalXML decodes each child element into a key/value pair
+func (m *Metadata) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	values, err := decodeKeyValues(d)
+	if err != nil {
+		return err
+	}
+	*m = values
+	return nil
+}
+
+// UnmarshalJSON decodes metadata sent as a JSON object or, like the enter API
+// does, as a list of single-key objects such as [{"course":"math-101"}]
+func (m *Metadata) UnmarshalJSON(data []byte) error {
+	values, err := decodeJSONKeyValues(data)
+	if err != nil {
+		return err
+	}
+	*m = values
+	return nil
+}
+
+// MarshalXML encodes each key/value pair as a child element, sorted by key
+func (m Metadata) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if err := e.EncodeElement(m[k], xml.StartElement{Name: xml.Name{Local: k}}); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// decodeKeyValues reads child elements of the current element as key/value pairs
+// until the matching end element is reached
+func decodeKeyValues(d *xml.Decoder) (map[string]string, error) {
+	values := map[string]string{}
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			var value string
+			if err := d.DecodeElement(&value, &t); err != nil {
+				return nil, err
+			}
+			values[t.Name.Local] = value
+		case xml.EndElement:
+			return values, nil
+		}
+	}
+}
+
+// decodeJSONKeyValues flattens a JSON object, or a list of objects, into
+// key/value pairs. Values that are not strings keep their JSON text.
+func decodeJSONKeyValues(data []byte) (map[string]string, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+
+	var objects []map[string]json.RawMessage
+	if len(data) > 0 && data[0] == '[' {
+		if err := json.Unmarshal(data, &objects); err != nil {
+			return nil, err
+		}
+	} else {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(data, &object); err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+
+	values := map[string]string{}
+	for _, object := range objects {
+		for k, raw := range object {
+			var s string
+			if json.Unmarshal(raw, &s) != nil {
+				s = string(raw)
+			}
+			values[k] = s
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,60 @@
+package responses_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadata_RoundTrip(t *testing.T) {
+	type envelope struct {
+		XMLName  xml.Name           `xml:"recording"`
+		Metadata responses.Metadata `xml:"metadata"`
+	}
+
+	in := envelope{Metadata: responses.Metadata{
+		"meetingName": "Math & Physics",
+		"bbb-origin":  "Moodle",
+	}}
+
+	out, err := xml.Marshal(in)
+	require.NoError(t, err)
+	assert.Equal(t, `<recording><metadata><bbb-origin>Moodle</bbb-origin><meetingName>Math &amp; Physics</meetingName></metadata></recording>`, string(out))
+
+	var decoded envelope
+	require.NoError(t, xml.Unmarshal(out, &decoded))
+	assert.Equal(t, in.Metadata, decoded.Metadata)
+}
+
+func TestMetadata_Empty(t *testing.T) {
+	var resp responses.GetMeetingInfoResponse
+	require.NoError(t, xml.Unmarshal([]byte(`<response><returncode>SUCCESS</returncode><metadata/></response>`), &resp))
+	assert.NotNil(t, resp.Metadata)
+	assert.Empty(t, resp.Metadata)
+}
+
+func TestMetadata_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want responses.Metadata
+	}{
+		{"list", `[{"course":"math-101"},{"bbb-origin":"Moodle"}]`, responses.Metadata{"course": "math-101", "bbb-origin": "Moodle"}},
+		{"empty list", `[]`, responses.Metadata{}},
+		{"object", `{"course":"math-101"}`, responses.Metadata{"course": "math-101"}},
+		{"non-string value", `[{"listed":true}]`, responses.Metadata{"listed": "true"}},
+		{"null", `null`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m responses.Metadata
+			require.NoError(t, json.Unmarshal([]byte(tt.in), &m))
+			assert.Equal(t, tt.want, m)
+		})
+	}
+}
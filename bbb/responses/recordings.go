@@ -5,13 +5,23 @@ This file defines the response types for recordings-related API operations such
 
 package responses
 
-// RecordingFormat represents a recording format in the API response
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecordingFormat represents a playback or data format of a recording, e.g.
+// presentation, video, podcast, screenshare or notes
 type RecordingFormat struct {
-	Type       string            `xml:"type,attr"`
-	URL        string            `xml:"url,omitempty"`
-	Length     int               `xml:"length,omitempty"`
-	Processing string            `xml:"processing,omitempty"`
-	Preview    *RecordingPreview `xml:"preview,omitempty"`
+	Type           string            `xml:"type"`
+	URL            string            `xml:"url"`
+	ProcessingTime int64             `xml:"processingTime,omitempty"` // Milliseconds spent processing the format
+	Length         int               `xml:"length"`                   // Minutes
+	Size           int64             `xml:"size,omitempty"`           // Bytes
+	Preview        *RecordingPreview `xml:"preview,omitempty"`
 }
 
 // RecordingPreview represents the preview images for a recording
@@ -24,39 +34,98 @@ type RecordingImage struct {
 	Alt    string `xml:"alt,attr"`
 	Height int    `xml:"height,attr"`
 	Width  int    `xml:"width,attr"`
-	Link   string `xml:"link,attr"`
+	Link   string `xml:",chardata"`
+}
+
+// RecordingBreakout links the recording of a breakout room to its parent meeting
+type RecordingBreakout struct {
+	ParentID string `xml:"parentId"`
+	Sequence int    `xml:"sequence"`
+	FreeJoin bool   `xml:"freeJoin"`
 }
 
 // Recording represents a recording in the API response
 type Recording struct {
-	RecordID        string             `xml:"recordID"`
-	MeetingID       string             `xml:"meetingID"`
-	Name            string             `xml:"name"`
-	Published       bool               `xml:"published"`
-	State           string             `xml:"state"`
-	StartTime       string             `xml:"startTime"`
-	EndTime         string             `xml:"endTime"`
-	Participants    int                `xml:"participants"`
-	Metadata        map[string]string  `xml:"metadata"`
-	Playback        *RecordingPlayback `xml:"playback"`
-	RawSize         int64              `xml:"rawSize"`
-	Size            int64              `xml:"size"`
-	IsBreakout      bool               `xml:"isBreakout"`
-	ParentMeetingID string             `xml:"parentMeetingID"`
-}
-
-// RecordingPlayback represents the playback information for a recording
-type RecordingPlayback struct {
-	Type    string            `xml:"type,attr"`
-	URL     string            `xml:"url"`
-	Length  int               `xml:"length,omitempty"`
-	Preview *RecordingPreview `xml:"preview,omitempty"`
+	RecordID          string             `xml:"recordID"`
+	MeetingID         string             `xml:"meetingID"`
+	InternalMeetingID string             `xml:"internalMeetingID"`
+	Name              string             `xml:"name"`
+	IsBreakout        bool               `xml:"isBreakout"`
+	Published         bool               `xml:"published"`
+	State             string             `xml:"state"`
+	StartTime         time.Time          `xml:"-"` // Decoded from milliseconds since the epoch
+	EndTime           time.Time          `xml:"-"`
+	Participants      int                `xml:"participants"`
+	RawSize           int64              `xml:"rawSize"`
+	Size              int64              `xml:"size"`
+	Metadata          Metadata           `xml:"metadata"`
+	Breakout          *RecordingBreakout `xml:"breakout"`                   // Set on the recording of a breakout room
+	BreakoutRooms     []string           `xml:"breakoutRooms>breakoutRoom"` // Breakout rooms of the recorded meeting
+	ParentMeetingID   string             `xml:"parentMeetingID"`
+	Playback          []RecordingFormat  `xml:"playback>format"`
+	Data              []RecordingFormat  `xml:"data>format"`
+}
+
+// UnmarshalXML decodes a recording, converting its millisecond timestamps
+func (r *Recording) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Recording
+	aux := struct {
+		*plain
+		StartTime string `xml:"startTime"`
+		EndTime   string `xml:"endTime"`
+	}{plain: (*plain)(r)}
+	if err := d.DecodeElement(&aux, &start); err != nil {
+		return err
+	}
+
+	var err error
+	if r.StartTime, err = parseMillis(aux.StartTime); err != nil {
+		return fmt.Errorf("recording startTime: %w", err)
+	}
+	if r.EndTime, err = parseMillis(aux.EndTime); err != nil {
+		return fmt.Errorf("recording endTime: %w", err)
+	}
+	return nil
+}
+
+// Format returns the playback format of the given type, or nil if the
+// recording has none
+func (r *Recording) Format(formatType string) *RecordingFormat {
+	for i := range r.Playback {
+		if r.Playback[i].Type == formatType {
+			return &r.Playback[i]
+		}
+	}
+	return nil
+}
+
+// Duration returns the time between the start and the end of the recording
+func (r *Recording) Duration() time.Duration {
+	if r.StartTime.IsZero() || r.EndTime.IsZero() {
+		return 0
+	}
+	return r.EndTime.Sub(r.StartTime)
+}
+
+// parseMillis converts milliseconds since the epoch to a time. An empty
+// string gives the zero time.
+func parseMillis(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	ms, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(ms), nil
 }
 
 // GetRecordingsResponse represents the response from the getRecordings API
 type GetRecordingsResponse struct {
 	BaseResponseImpl
-	Recordings []Recording `xml:"recordings>recording"`
+	Recordings    []Recording `xml:"recordings>recording"`
+	TotalElements int         `xml:"totalElements"` // Number of matching recordings over all pages, reported since BigBlueButton 2.6
 }
 
 // PublishRecordingsResponse represents the response from the publishRecordings API
@@ -76,3 +145,24 @@ type UpdateRecordingsResponse struct {
 	BaseResponseImpl
 	Updated bool `xml:"updated"`
 }
+
+// TextTrack represents a caption or subtitle track of a recording
+type TextTrack struct {
+	Href   string `json:"href"`
+	Kind   string `json:"kind"` // "subtitles" or "captions"
+	Label  string `json:"label"`
+	Lang   string `json:"lang"`
+	Source string `json:"source"` // e.g. "upload" or "live"
+}
+
+// GetRecordingTextTracksResponse represents the response from the getRecordingTextTracks API
+type GetRecordingTextTracksResponse struct {
+	BaseResponseImpl
+	Tracks []TextTrack `json:"tracks"`
+}
+
+// PutRecordingTextTrackResponse represents the response from the putRecordingTextTrack API
+type PutRecordingTextTrackResponse struct {
+	BaseResponseImpl
+	RecordID string `json:"recordId"`
+}
@@ -0,0 +1,132 @@
+package responses_test
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const recordingsXML = `
+<response>
+	<returncode>SUCCESS</returncode>
+	<recordings>
+		<recording>
+			<recordID>ffbfc4cc24428694e8b53a4e144f414052431693-1530718721124</recordID>
+			<meetingID>c637ba21adcd0191f48f5c4bf23fab0f96ed5c18</meetingID>
+			<internalMeetingID>ffbfc4cc24428694e8b53a4e144f414052431693-1530718721124</internalMeetingID>
+			<name>Fred's Room</name>
+			<isBreakout>false</isBreakout>
+			<published>true</published>
+			<state>published</state>
+			<startTime>1530718721124</startTime>
+			<endTime>1530718810456</endTime>
+			<participants>3</participants>
+			<rawSize>951067</rawSize>
+			<metadata>
+				<meetingName>Fred's Room</meetingName>
+			</metadata>
+			<breakoutRooms>
+				<breakoutRoom>ae8e6e2c9f7a4a5b-1530718750000</breakoutRoom>
+				<breakoutRoom>ae8e6e2c9f7a4a5c-1530718750000</breakoutRoom>
+			</breakoutRooms>
+			<size>597667</size>
+			<playback>
+				<format>
+					<type>presentation</type>
+					<url>https://demo.bigbluebutton.org/playback/presentation/2.3/ffbfc4cc24428694e8b53a4e144f414052431693-1530718721124</url>
+					<processingTime>7177</processingTime>
+					<length>1</length>
+					<size>597667</size>
+					<preview>
+						<images>
+							<image alt="Welcome" height="136" width="176">https://demo.bigbluebutton.org/presentation/thumbnails/thumb-1.png</image>
+							<image alt="Slide 2" height="136" width="176">https://demo.bigbluebutton.org/presentation/thumbnails/thumb-2.png</image>
+						</images>
+					</preview>
+				</format>
+				<format>
+					<type>video</type>
+					<url>https://demo.bigbluebutton.org/playback/video/ffbfc4cc24428694e8b53a4e144f414052431693-1530718721124/</url>
+					<processingTime>0</processingTime>
+					<length>1</length>
+					<size>1104836</size>
+				</format>
+			</playback>
+			<data>
+				<format>
+					<type>notes</type>
+					<url>https://demo.bigbluebutton.org/notes/ffbfc4cc24428694e8b53a4e144f414052431693-1530718721124/notes.pdf</url>
+					<length>0</length>
+				</format>
+			</data>
+		</recording>
+		<recording>
+			<recordID>ae8e6e2c9f7a4a5b-1530718750000</recordID>
+			<meetingID>c637ba21adcd0191f48f5c4bf23fab0f96ed5c18-1</meetingID>
+			<isBreakout>true</isBreakout>
+			<state>processing</state>
+			<startTime></startTime>
+			<breakout>
+				<parentId>ffbfc4cc24428694e8b53a4e144f414052431693-1530718721124</parentId>
+				<sequence>1</sequence>
+				<freeJoin>false</freeJoin>
+			</breakout>
+		</recording>
+	</recordings>
+</response>`
+
+func TestRecording_Decode(t *testing.T) {
+	var resp responses.GetRecordingsResponse
+	require.NoError(t, xml.Unmarshal([]byte(recordingsXML), &resp))
+	require.Len(t, resp.Recordings, 2)
+
+	rec := resp.Recordings[0]
+	assert.Equal(t, "ffbfc4cc24428694e8b53a4e144f414052431693-1530718721124", rec.InternalMeetingID)
+	assert.Equal(t, time.UnixMilli(1530718721124), rec.StartTime)
+	assert.Equal(t, time.UnixMilli(1530718810456), rec.EndTime)
+	assert.Equal(t, 89332*time.Millisecond, rec.Duration())
+	assert.Equal(t, int64(951067), rec.RawSize)
+	assert.Equal(t, "Fred's Room", rec.Metadata["meetingName"])
+	assert.Nil(t, rec.Breakout)
+	assert.Len(t, rec.BreakoutRooms, 2)
+
+	require.Len(t, rec.Playback, 2)
+	presentation := rec.Format("presentation")
+	require.NotNil(t, presentation)
+	assert.Equal(t, int64(7177), presentation.ProcessingTime)
+	assert.Equal(t, 1, presentation.Length)
+	assert.Equal(t, int64(597667), presentation.Size)
+	require.NotNil(t, presentation.Preview)
+	require.Len(t, presentation.Preview.Images, 2)
+	assert.Equal(t, responses.RecordingImage{
+		Alt: "Welcome", Height: 136, Width: 176,
+		Link: "https://demo.bigbluebutton.org/presentation/thumbnails/thumb-1.png",
+	}, presentation.Preview.Images[0])
+
+	video := rec.Format("video")
+	require.NotNil(t, video)
+	assert.Nil(t, video.Preview)
+	assert.Nil(t, rec.Format("podcast"))
+
+	require.Len(t, rec.Data, 1)
+	assert.Equal(t, "notes", rec.Data[0].Type)
+
+	breakout := resp.Recordings[1]
+	assert.True(t, breakout.IsBreakout)
+	assert.True(t, breakout.StartTime.IsZero())
+	assert.Zero(t, breakout.Duration())
+	require.NotNil(t, breakout.Breakout)
+	assert.Equal(t, "ffbfc4cc24428694e8b53a4e144f414052431693-1530718721124", breakout.Breakout.ParentID)
+	assert.Equal(t, 1, breakout.Breakout.Sequence)
+	assert.Empty(t, breakout.Playback)
+}
+
+func TestRecording_InvalidTime(t *testing.T) {
+	var resp responses.GetRecordingsResponse
+	err := xml.Unmarshal([]byte(`<response><recordings><recording><startTime>yesterday</startTime></recording></recordings></response>`), &resp)
+	assert.ErrorContains(t, err, "startTime")
+}
@@ -0,0 +1,15 @@
+/*
+Package responses contains response structures for BigBlueButton API calls.
+This file defines the response of the API root, which describes the server.
+*/
+
+package responses
+
+// VersionResponse represents the response of the API root
+type VersionResponse struct {
+	BaseResponseImpl
+	Version             string `xml:"version"`
+	APIVersion          string `xml:"apiVersion"`
+	BBBVersion          string `xml:"bbbVersion,omitempty"`          // Reported since BigBlueButton 2.6
+	GraphqlWebsocketURL string `xml:"graphqlWebsocketUrl,omitempty"` // Reported since BigBlueButton 3.0
+}
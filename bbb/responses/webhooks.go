@@ -5,10 +5,14 @@ This file defines the response types for webhook-related API operations such as
 
 package responses
 
+import "strings"
+
 // CreateHookResponse represents the response from creating a webhook
 type CreateHookResponse struct {
 	BaseResponseImpl
-	HookID string `xml:"hookID"`
+	HookID    string `xml:"hookID"`
+	Permanent bool   `xml:"permanentHook"`
+	Raw       bool   `xml:"rawData"`
 }
 
 // HooksResponse represents a list of webhooks
@@ -19,13 +23,26 @@ type HooksResponse struct {
 
 // HookDetails represents detailed information about a webhook
 type HookDetails struct {
-	ID          string            `xml:"hookID"`
-	CallbackURL string            `xml:"callbackURL"`
-	MeetingID   string            `xml:"meetingID,omitempty"`
-	Permanent   bool              `xml:"permanent"`
-	Raw         bool              `xml:"raw"`
-	Metadata    map[string]string `xml:"metadata>entry"` // This might need custom unmarshaling
-	CreatedAt   string            `xml:"createdAt,omitempty"`
+	ID          string   `xml:"hookID"`
+	CallbackURL string   `xml:"callbackURL"`
+	MeetingID   string   `xml:"meetingID,omitempty"`
+	EventID     string   `xml:"eventID,omitempty"` // Comma-separated event filter; empty for all events
+	Permanent   bool     `xml:"permanentHook"`
+	Raw         bool     `xml:"rawData"`
+	Metadata    Metadata `xml:"metadata"`
+	CreatedAt   string   `xml:"createdAt,omitempty"`
+}
+
+// EventIDs returns the events the hook is filtered on, or nil when it receives all events
+func (h HookDetails) EventIDs() []string {
+	if h.EventID == "" {
+		return nil
+	}
+	ids := strings.Split(h.EventID, ",")
+	for i := range ids {
+		ids[i] = strings.TrimSpace(ids[i])
+	}
+	return ids
 }
 
 // DestroyHookResponse represents the response from destroying a webhook
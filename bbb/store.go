@@ -0,0 +1,151 @@
+/*
+Package bbb provides a Go client for the BigBlueButton API.
+
+This file contains the stores a Pool uses to remember which server hosts
+each meeting.
+*/
+
+package bbb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// MeetingStore records the server hosting each meeting of a Pool.
+// Implementations must be safe for concurrent use.
+type MeetingStore interface {
+	// Get returns the ID of the server hosting a meeting, or false if the meeting is unknown.
+	Get(ctx context.Context, meetingID string) (serverID string, ok bool, err error)
+	// Put records the server hosting a meeting.
+	Put(ctx context.Context, meetingID, serverID string) error
+	// Delete forgets a meeting. Deleting an unknown meeting is not an error.
+	Delete(ctx context.Context, meetingID string) error
+}
+
+// MemoryStore is a MeetingStore keeping the meetings in memory.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	meetings map[string]string
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{meetings: make(map[string]string)}
+}
+
+// Get implements MeetingStore.
+func (s *MemoryStore) Get(ctx context.Context, meetingID string) (string, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	serverID, ok := s.meetings[meetingID]
+	return serverID, ok, nil
+}
+
+// Put implements MeetingStore.
+func (s *MemoryStore) Put(ctx context.Context, meetingID, serverID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.meetings[meetingID] = serverID
+	return nil
+}
+
+// Delete implements MeetingStore.
+func (s *MemoryStore) Delete(ctx context.Context, meetingID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.meetings, meetingID)
+	return nil
+}
+
+// FileStore is a MeetingStore keeping the meetings in memory and in a JSON
+// file, so that they survive restarts. The file is rewritten on every change.
+type FileStore struct {
+	path string
+
+	mu       sync.RWMutex
+	meetings map[string]string
+}
+
+// NewFileStore creates a FileStore backed by the file at path, loading the
+// meetings it already holds. A missing file is created on the first change.
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path, meetings: make(map[string]string)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading meeting store: %w", err)
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &s.meetings); err != nil {
+			return nil, fmt.Errorf("parsing meeting store: %w", err)
+		}
+	}
+	return s, nil
+}
+
+// Get implements MeetingStore.
+func (s *FileStore) Get(ctx context.Context, meetingID string) (string, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	serverID, ok := s.meetings[meetingID]
+	return serverID, ok, nil
+}
+
+// Put implements MeetingStore.
+func (s *FileStore) Put(ctx context.Context, meetingID, serverID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if current, ok := s.meetings[meetingID]; ok && current == serverID {
+		return nil
+	}
+	s.meetings[meetingID] = serverID
+	return s.save()
+}
+
+// Delete implements MeetingStore.
+func (s *FileStore) Delete(ctx context.Context, meetingID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.meetings[meetingID]; !ok {
+		return nil
+	}
+	delete(s.meetings, meetingID)
+	return s.save()
+}
+
+// save writes the meetings to a temporary file and renames it over the store's
+// file, so that a crash never leaves a partial file behind. s.mu must be held.
+func (s *FileStore) save() error {
+	data, err := json.Marshal(s.meetings)
+	if err != nil {
+		return fmt.Errorf("encoding meeting store: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("writing meeting store: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing meeting store: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing meeting store: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("writing meeting store: %w", err)
+	}
+	return nil
+}
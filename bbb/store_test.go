@@ -0,0 +1,77 @@
+package bbb_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------- MeetingStore --------------------
+
+func testMeetingStore(t *testing.T, store bbb.MeetingStore) {
+	ctx := context.Background()
+
+	_, ok, err := store.Get(ctx, "m1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, store.Put(ctx, "m1", "bbb1"))
+	id, ok, err := store.Get(ctx, "m1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "bbb1", id)
+
+	require.NoError(t, store.Delete(ctx, "m1"))
+	require.NoError(t, store.Delete(ctx, "m1"))
+	_, ok, err = store.Get(ctx, "m1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestMemoryStore(t *testing.T) {
+	testMeetingStore(t, bbb.NewMemoryStore())
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "meetings.json")
+	store, err := bbb.NewFileStore(path)
+	require.NoError(t, err)
+	testMeetingStore(t, store)
+
+	// Meetings survive reopening the store.
+	require.NoError(t, store.Put(context.Background(), "m2", "bbb2"))
+	reopened, err := bbb.NewFileStore(path)
+	require.NoError(t, err)
+	id, ok, err := reopened.Get(context.Background(), "m2")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "bbb2", id)
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	_, err = bbb.NewFileStore(path)
+	require.Error(t, err)
+}
+
+func TestPool_MeetingStore(t *testing.T) {
+	a := newFakeNode(nil)
+	b := newFakeNode(nil)
+	store := bbb.NewMemoryStore()
+	require.NoError(t, store.Put(context.Background(), "known", "b"))
+
+	pool := newPool(t, []*fakeNode{a, b}, bbb.WithMeetingStore(store))
+
+	// The stored server is used without looking the meeting up.
+	_, err := pool.CreateMeeting(context.Background(), &requests.CreateMeetingRequest{MeetingID: "known", Name: "Known"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, b.calls["create"])
+	assert.Equal(t, 0, a.calls["getMeetings"]+b.calls["getMeetings"])
+
+	_, err = bbb.NewPool(pool.Servers(), bbb.WithMeetingStore(nil))
+	require.Error(t, err)
+}
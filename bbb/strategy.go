@@ -0,0 +1,127 @@
+/*
+Package bbb provides a Go client for the BigBlueButton API.
+
+This file contains the strategies a Pool uses to choose the server of a
+new meeting.
+*/
+
+package bbb
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
+)
+
+// Strategy chooses the server on which a Pool creates a new meeting.
+type Strategy interface {
+	// Pick returns one of servers, which is never empty.
+	Pick(ctx context.Context, servers []*Server) (*Server, error)
+}
+
+// StrategyFunc adapts a function to the Strategy interface.
+type StrategyFunc func(ctx context.Context, servers []*Server) (*Server, error)
+
+// Pick calls f.
+func (f StrategyFunc) Pick(ctx context.Context, servers []*Server) (*Server, error) {
+	return f(ctx, servers)
+}
+
+// LeastMeetings picks the server running the fewest meetings.
+// Servers that cannot be queried are skipped.
+func LeastMeetings() Strategy {
+	return leastLoaded(func(resp *responses.GetMeetingsResponse) int {
+		return len(resp.Meetings)
+	})
+}
+
+// LeastParticipants picks the server with the fewest participants over all its
+// meetings. Servers that cannot be queried are skipped.
+func LeastParticipants() Strategy {
+	return leastLoaded(func(resp *responses.GetMeetingsResponse) int {
+		n := 0
+		for _, m := range resp.Meetings {
+			n += m.ParticipantCount
+		}
+		return n
+	})
+}
+
+// leastLoaded returns a strategy picking the server with the lowest load, as
+// computed from its getMeetings response. Ties go to the first server.
+func leastLoaded(load func(*responses.GetMeetingsResponse) int) Strategy {
+	return StrategyFunc(func(ctx context.Context, servers []*Server) (*Server, error) {
+		loads := make([]int, len(servers))
+		errs := make([]error, len(servers))
+
+		var wg sync.WaitGroup
+		for i, s := range servers {
+			wg.Add(1)
+			go func(i int, s *Server) {
+				defer wg.Done()
+				resp, err := s.Client.GetMeetings(ctx)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				loads[i] = load(resp)
+			}(i, s)
+		}
+		wg.Wait()
+
+		best := -1
+		for i := range servers {
+			if errs[i] == nil && (best < 0 || loads[i] < loads[best]) {
+				best = i
+			}
+		}
+		if best < 0 {
+			return nil, errors.Join(append([]error{ErrNoServer}, errs...)...)
+		}
+		return servers[best], nil
+	})
+}
+
+// RoundRobin picks the servers in turn.
+func RoundRobin() Strategy {
+	var (
+		mu   sync.Mutex
+		next int
+	)
+	return StrategyFunc(func(ctx context.Context, servers []*Server) (*Server, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		s := servers[next%len(servers)]
+		next++
+		return s, nil
+	})
+}
+
+// Weighted picks the servers in proportion to their Weight, interleaving them
+// smoothly: with weights 2 and 1 the servers are picked as A, B, A, A, B, A, …
+func Weighted() Strategy {
+	var mu sync.Mutex
+	current := make(map[string]int)
+	return StrategyFunc(func(ctx context.Context, servers []*Server) (*Server, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		total := 0
+		var best *Server
+		for _, s := range servers {
+			w := s.Weight
+			if w < 1 {
+				w = 1
+			}
+			total += w
+			current[s.ID] += w
+			if best == nil || current[s.ID] > current[best.ID] {
+				best = s
+			}
+		}
+		current[best.ID] -= total
+		return best, nil
+	})
+}
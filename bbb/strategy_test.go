@@ -0,0 +1,42 @@
+package bbb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pick runs a strategy n times and returns the IDs of the picked servers.
+func pick(t *testing.T, s bbb.Strategy, servers []*bbb.Server, n int) []string {
+	t.Helper()
+
+	var ids []string
+	for i := 0; i < n; i++ {
+		server, err := s.Pick(context.Background(), servers)
+		require.NoError(t, err)
+		ids = append(ids, server.ID)
+	}
+	return ids
+}
+
+// -------------------- Strategies --------------------
+
+func TestRoundRobin(t *testing.T) {
+	servers := []*bbb.Server{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+	assert.Equal(t, []string{"a", "b", "c", "a"}, pick(t, bbb.RoundRobin(), servers, 4))
+}
+
+func TestWeighted(t *testing.T) {
+	servers := []*bbb.Server{{ID: "a", Weight: 2}, {ID: "b"}}
+	assert.Equal(t, []string{"a", "b", "a", "a", "b", "a"}, pick(t, bbb.Weighted(), servers, 6))
+
+	servers = []*bbb.Server{{ID: "a", Weight: 5}, {ID: "b", Weight: 1}, {ID: "c", Weight: 4}}
+	counts := map[string]int{}
+	for _, id := range pick(t, bbb.Weighted(), servers, 100) {
+		counts[id]++
+	}
+	assert.Equal(t, map[string]int{"a": 50, "b": 10, "c": 40}, counts)
+}
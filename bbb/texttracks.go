@@ -0,0 +1,126 @@
+/*
+Package bbb provides functionality for managing BigBlueButton recordings.
+This file contains the calls for listing and uploading the caption and subtitle
+tracks of recordings.
+*/
+
+package bbb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
+)
+
+// GetRecordingTextTracks retrieves the caption and subtitle tracks of a recording.
+func (c *Client) GetRecordingTextTracks(ctx context.Context, recordID string) (*responses.GetRecordingTextTracksResponse, error) {
+	if recordID == "" {
+		return nil, NewError(ErrMissingParam, "recordID is required")
+	}
+
+	params := url.Values{
+		"recordID": {recordID},
+	}
+
+	// Make the API call
+	var response responses.GetRecordingTextTracksResponse
+	if err := c.doJSONRequest(ctx, "getRecordingTextTracks", params, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// PutRecordingTextTrack uploads a WebVTT or SRT caption file to a recording,
+// replacing any track with the same kind and language. Without a file, the
+// track is removed. The server processes the upload asynchronously.
+func (c *Client) PutRecordingTextTrack(ctx context.Context, req *requests.PutRecordingTextTrackRequest) (*responses.PutRecordingTextTrackResponse, error) {
+	if req == nil {
+		return nil, NewError(ErrInvalidParam, "request cannot be nil")
+	}
+
+	// Validate required parameters
+	if req.RecordID == "" {
+		return nil, NewError(ErrMissingParam, "recordID is required")
+	}
+	if req.Kind == "" {
+		return nil, NewError(ErrMissingParam, "kind is required")
+	}
+	if !req.Kind.Valid() {
+		return nil, NewError(ErrInvalidParam, "invalid kind: "+string(req.Kind))
+	}
+	if req.Lang == "" {
+		return nil, NewError(ErrMissingParam, "lang is required")
+	}
+
+	params := url.Values{
+		"recordID": {req.RecordID},
+		"kind":     {string(req.Kind)},
+		"lang":     {req.Lang},
+	}
+	setString(params, "label", req.Label)
+
+	payload, err := textTrackBody(req.Filename, req.File)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the API call
+	var response responses.PutRecordingTextTrackResponse
+	if err := c.doJSONRequestWithBody(ctx, "putRecordingTextTrack", params, payload, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// textTrackBody encodes a caption file as multipart form data with a single "file" field.
+func textTrackBody(filename string, file io.Reader) (*requestBody, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	if file != nil {
+		if filename == "" {
+			filename = "captions.vtt"
+		}
+
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename=%q`, filepath.Base(filename)))
+		header.Set("Content-Type", textTrackContentType(filename))
+
+		part, err := w.CreatePart(header)
+		if err != nil {
+			return nil, fmt.Errorf("encoding text track: %w", err)
+		}
+		if _, err := io.Copy(part, file); err != nil {
+			return nil, fmt.Errorf("reading text track: %w", err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("encoding text track: %w", err)
+	}
+
+	return &requestBody{contentType: w.FormDataContentType(), data: buf.Bytes()}, nil
+}
+
+// textTrackContentType returns the media type of a caption file from its extension.
+func textTrackContentType(filename string) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".srt":
+		return "application/x-subrip"
+	case ".vtt":
+		return "text/vtt"
+	default:
+		return "application/octet-stream"
+	}
+}
@@ -0,0 +1,114 @@
+package bbb_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------- GetRecordingTextTracks --------------------
+
+func TestGetRecordingTextTracks(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/getRecordingTextTracks", r.URL.Path)
+		assert.Equal(t, "rec-123", r.URL.Query().Get("recordID"))
+
+		w.Write([]byte(`{"response":{"returncode":"SUCCESS","tracks":[
+			{"href":"https://bbb.example.com/presentation/rec-123/caption_en-US.vtt","kind":"subtitles","label":"English","lang":"en-US","source":"upload"},
+			{"href":"https://bbb.example.com/presentation/rec-123/caption_pt-BR.vtt","kind":"captions","label":"Português","lang":"pt-BR","source":"live"}
+		]}}`))
+	})
+
+	resp, err := client.GetRecordingTextTracks(context.Background(), "rec-123")
+	require.NoError(t, err)
+	require.Len(t, resp.Tracks, 2)
+	assert.Equal(t, "subtitles", resp.Tracks[0].Kind)
+	assert.Equal(t, "English", resp.Tracks[0].Label)
+	assert.Equal(t, "en-US", resp.Tracks[0].Lang)
+	assert.Equal(t, "upload", resp.Tracks[0].Source)
+	assert.Equal(t, "https://bbb.example.com/presentation/rec-123/caption_en-US.vtt", resp.Tracks[0].Href)
+	assert.Equal(t, "live", resp.Tracks[1].Source)
+}
+
+func TestGetRecordingTextTracks_Errors(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"returncode":"FAILED","messageKey":"noRecordings","message":"No recording was found for rec-404"}}`))
+	})
+
+	_, err := client.GetRecordingTextTracks(context.Background(), "rec-404")
+	var apiErr *bbb.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "noRecordings", apiErr.MessageKey)
+
+	_, err = client.GetRecordingTextTracks(context.Background(), "")
+	assert.True(t, bbb.IsError(err, bbb.ErrMissingParam))
+}
+
+// -------------------- PutRecordingTextTrack --------------------
+
+func TestPutRecordingTextTrack(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/putRecordingTextTrack", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		q := r.URL.Query()
+		assert.Equal(t, "rec-123", q.Get("recordID"))
+		assert.Equal(t, "captions", q.Get("kind"))
+		assert.Equal(t, "en-US", q.Get("lang"))
+		assert.Equal(t, "English (corrected)", q.Get("label"))
+
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		defer file.Close()
+		assert.Equal(t, "lecture.vtt", header.Filename)
+		assert.Equal(t, "text/vtt", header.Header.Get("Content-Type"))
+		content, _ := io.ReadAll(file)
+		assert.Equal(t, "WEBVTT\n\n00:00.000 --> 00:01.000\nHello\n", string(content))
+
+		w.Write([]byte(`{"response":{"returncode":"SUCCESS","messageKey":"upload_text_track_success","message":"Text track uploaded successfully","recordId":"rec-123"}}`))
+	})
+
+	resp, err := client.PutRecordingTextTrack(context.Background(), &requests.PutRecordingTextTrackRequest{
+		RecordID: "rec-123",
+		Kind:     requests.TextTrackCaptions,
+		Lang:     "en-US",
+		Label:    "English (corrected)",
+		Filename: "/tmp/lecture.vtt",
+		File:     strings.NewReader("WEBVTT\n\n00:00.000 --> 00:01.000\nHello\n"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "rec-123", resp.RecordID)
+	assert.Equal(t, "upload_text_track_success", resp.MessageKey)
+}
+
+func TestPutRecordingTextTrack_Validation(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request expected")
+	})
+
+	tests := []struct {
+		name string
+		req  *requests.PutRecordingTextTrackRequest
+		code string
+	}{
+		{"nil request", nil, bbb.ErrInvalidParam},
+		{"missing recordID", &requests.PutRecordingTextTrackRequest{Kind: requests.TextTrackSubtitles, Lang: "en"}, bbb.ErrMissingParam},
+		{"missing kind", &requests.PutRecordingTextTrackRequest{RecordID: "r", Lang: "en"}, bbb.ErrMissingParam},
+		{"invalid kind", &requests.PutRecordingTextTrackRequest{RecordID: "r", Kind: "chapters", Lang: "en"}, bbb.ErrInvalidParam},
+		{"missing lang", &requests.PutRecordingTextTrackRequest{RecordID: "r", Kind: requests.TextTrackSubtitles}, bbb.ErrMissingParam},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.PutRecordingTextTrack(context.Background(), tt.req)
+			assert.True(t, bbb.IsError(err, tt.code), err)
+		})
+	}
+}
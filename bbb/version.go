@@ -0,0 +1,192 @@
+/*
+Package bbb provides a Go client for the BigBlueButton API.
+
+This file contains GetVersion and the version and feature detection helpers
+used to adapt calls to the generation of a server.
+*/
+
+package bbb
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
+)
+
+// Version is a semantic version such as 2.7.3 or 3.0.0-rc.2.
+type Version struct {
+	Major      int
+	Minor      int
+	Patch      int
+	Prerelease string
+}
+
+// ParseVersion parses a version of the form [v]MAJOR.MINOR[.PATCH][-PRERELEASE].
+// Build metadata after a "+" is ignored.
+func ParseVersion(s string) (Version, error) {
+	var v Version
+	rest := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	rest, _, _ = strings.Cut(rest, "+")
+	rest, v.Prerelease, _ = strings.Cut(rest, "-")
+
+	parts := strings.Split(rest, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid version: %q", s)
+	}
+	nums := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version: %q", s)
+		}
+		*nums[i] = n
+	}
+	return v, nil
+}
+
+// String returns the version in its canonical form.
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is lower than, equal to
+// or greater than o. Prereleases sort before their release and are compared as
+// strings among themselves.
+func (v Version) Compare(o Version) int {
+	for _, d := range []int{v.Major - o.Major, v.Minor - o.Minor, v.Patch - o.Patch} {
+		if d < 0 {
+			return -1
+		}
+		if d > 0 {
+			return 1
+		}
+	}
+	switch {
+	case v.Prerelease == o.Prerelease:
+		return 0
+	case v.Prerelease == "":
+		return 1
+	case o.Prerelease == "":
+		return -1
+	case v.Prerelease < o.Prerelease:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// AtLeast reports whether v is major.minor or later. Prereleases of a minor
+// version count as that version, since they carry its API.
+func (v Version) AtLeast(major, minor int) bool {
+	if v.Major != major {
+		return v.Major > major
+	}
+	return v.Minor >= minor
+}
+
+// Feature is an API feature introduced in a given BigBlueButton release.
+type Feature string
+
+// API features that can be detected from the server version.
+const (
+	FeatureTextTracks           Feature = "textTracks"           // getRecordingTextTracks and putRecordingTextTrack, 2.2
+	FeatureDisabledFeatures     Feature = "disabledFeatures"     // disabledFeatures create parameter, 2.5
+	FeatureInsertDocument       Feature = "insertDocument"       // insertDocument, 2.5
+	FeatureSHA256               Feature = "sha256"               // SHA-256, SHA-384 and SHA-512 checksums, 2.6
+	FeatureRecordingsPagination Feature = "recordingsPagination" // offset and limit on getRecordings, 2.6
+	FeatureSendChatMessage      Feature = "sendChatMessage"      // sendChatMessage, 2.7
+	FeatureGetJoinURL           Feature = "getJoinUrl"           // getJoinUrl and the pluginManifests create parameter, 3.0
+	FeatureGraphQL              Feature = "graphql"              // GraphQL websocket API, 3.0
+)
+
+// featureVersions maps features to the release that introduced them.
+var featureVersions = map[Feature][2]int{
+	FeatureTextTracks:           {2, 2},
+	FeatureDisabledFeatures:     {2, 5},
+	FeatureInsertDocument:       {2, 5},
+	FeatureSHA256:               {2, 6},
+	FeatureRecordingsPagination: {2, 6},
+	FeatureSendChatMessage:      {2, 7},
+	FeatureGetJoinURL:           {3, 0},
+	FeatureGraphQL:              {3, 0},
+}
+
+// ServerVersion describes the software a server runs, as reported by the API root.
+type ServerVersion struct {
+	responses.VersionResponse
+
+	// BBB is the parsed BBBVersion. It is the zero Version when the server
+	// does not report its version: servers before BigBlueButton 2.6 never do,
+	// and later ones hide it unless configured to show it.
+	BBB Version
+}
+
+// Known reports whether the server reported a BigBlueButton version.
+func (v *ServerVersion) Known() bool {
+	return v.BBB != Version{}
+}
+
+// MinVersion returns the lowest release the server can run. It is BBB when
+// the version is known, and otherwise inferred from the fields only newer
+// releases report: a GraphQL websocket URL means 3.0 or later. It is the zero
+// Version when nothing is known.
+func (v *ServerVersion) MinVersion() Version {
+	switch {
+	case v.Known():
+		return v.BBB
+	case v.GraphqlWebsocketURL != "":
+		return Version{Major: 3}
+	default:
+		return Version{}
+	}
+}
+
+// Supports reports whether the server's release has a feature. It returns
+// false when MinVersion is too old for the feature or unknown.
+func (v *ServerVersion) Supports(f Feature) bool {
+	since, ok := featureVersions[f]
+	if !ok {
+		return false
+	}
+	min := v.MinVersion()
+	return min != Version{} && min.AtLeast(since[0], since[1])
+}
+
+// ChecksumAlgorithm returns the strongest algorithm the server is known to
+// accept, e.g. to configure a client with WithChecksumAlgorithm: SHA-256 from
+// BigBlueButton 2.6 on and SHA-1 before. The boolean is false when nothing is
+// known about the release, in which case WithChecksumNegotiation can find the
+// algorithm instead.
+func (v *ServerVersion) ChecksumAlgorithm() (ChecksumAlgorithm, bool) {
+	min := v.MinVersion()
+	switch {
+	case min == Version{}:
+		return "", false
+	case v.Supports(FeatureSHA256):
+		return ChecksumSHA256, true
+	default:
+		return ChecksumSHA1, true
+	}
+}
+
+// GetVersion calls the API root and returns the versions the server reports.
+// A bbbVersion that is not a semantic version leaves the parsed version unknown.
+func (c *Client) GetVersion(ctx context.Context) (*ServerVersion, error) {
+	var response responses.VersionResponse
+	if err := c.getRoot(ctx, &response); err != nil {
+		return nil, err
+	}
+
+	v := &ServerVersion{VersionResponse: response}
+	if parsed, err := ParseVersion(response.BBBVersion); err == nil {
+		v.BBB = parsed
+	}
+	return v, nil
+}
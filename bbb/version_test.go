@@ -0,0 +1,153 @@
+package bbb_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------- ParseVersion --------------------
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    bbb.Version
+		wantErr bool
+	}{
+		{input: "2.7.3", want: bbb.Version{Major: 2, Minor: 7, Patch: 3}},
+		{input: "v3.0.0-rc.2", want: bbb.Version{Major: 3, Minor: 0, Prerelease: "rc.2"}},
+		{input: "2.6", want: bbb.Version{Major: 2, Minor: 6}},
+		{input: "3.0.1+build.5", want: bbb.Version{Major: 3, Patch: 1}},
+		{input: "", wantErr: true},
+		{input: "3", wantErr: true},
+		{input: "2.x.1", wantErr: true},
+		{input: "1.2.3.4", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := bbb.ParseVersion(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	v := func(s string) bbb.Version {
+		parsed, err := bbb.ParseVersion(s)
+		require.NoError(t, err)
+		return parsed
+	}
+
+	assert.Equal(t, 0, v("2.7.3").Compare(v("2.7.3")))
+	assert.Equal(t, -1, v("2.7.3").Compare(v("2.7.10")))
+	assert.Equal(t, 1, v("3.0.0").Compare(v("2.7.10")))
+	assert.Equal(t, -1, v("3.0.0-rc.1").Compare(v("3.0.0")))
+	assert.Equal(t, -1, v("3.0.0-beta.1").Compare(v("3.0.0-rc.1")))
+	assert.Equal(t, "3.0.0-rc.1", v("v3.0-rc.1").String())
+
+	assert.True(t, v("3.0.0-rc.1").AtLeast(3, 0))
+	assert.True(t, v("2.7.3").AtLeast(2, 5))
+	assert.False(t, v("2.7.3").AtLeast(3, 0))
+}
+
+// -------------------- GetVersion --------------------
+
+func TestGetVersion(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/", r.URL.Path)
+		w.Write([]byte(`
+		<response>
+		  <returncode>SUCCESS</returncode>
+		  <version>2.0</version>
+		  <apiVersion>2.0</apiVersion>
+		  <bbbVersion>3.0.4</bbbVersion>
+		  <graphqlWebsocketUrl>wss://bbb.example.com/graphql</graphqlWebsocketUrl>
+		</response>`))
+	})
+
+	v, err := client.GetVersion(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "2.0", v.APIVersion)
+	assert.Equal(t, "3.0.4", v.BBBVersion)
+	assert.Equal(t, "wss://bbb.example.com/graphql", v.GraphqlWebsocketURL)
+	assert.Equal(t, bbb.Version{Major: 3, Patch: 4}, v.BBB)
+	assert.True(t, v.Known())
+	assert.True(t, v.Supports(bbb.FeatureGetJoinURL))
+	assert.True(t, v.Supports(bbb.FeatureInsertDocument))
+	alg, ok := v.ChecksumAlgorithm()
+	assert.True(t, ok)
+	assert.Equal(t, bbb.ChecksumSHA256, alg)
+}
+
+func TestGetVersion_Features(t *testing.T) {
+	tests := []struct {
+		name       string
+		bbbVersion string
+		graphqlURL string
+		supported  []bbb.Feature
+		missing    []bbb.Feature
+		checksum   bbb.ChecksumAlgorithm
+	}{
+		{
+			name:       "2.5",
+			bbbVersion: "2.5.18",
+			supported:  []bbb.Feature{bbb.FeatureInsertDocument, bbb.FeatureDisabledFeatures},
+			missing:    []bbb.Feature{bbb.FeatureSHA256, bbb.FeatureGetJoinURL},
+			checksum:   bbb.ChecksumSHA1,
+		},
+		{
+			name:       "2.7",
+			bbbVersion: "2.7.3",
+			supported:  []bbb.Feature{bbb.FeatureSHA256, bbb.FeatureSendChatMessage},
+			missing:    []bbb.Feature{bbb.FeatureGetJoinURL, bbb.FeatureGraphQL},
+			checksum:   bbb.ChecksumSHA256,
+		},
+		{
+			name:       "hidden 3.0",
+			graphqlURL: "wss://bbb.example.com/graphql",
+			supported:  []bbb.Feature{bbb.FeatureSHA256, bbb.FeatureInsertDocument, bbb.FeatureGetJoinURL, bbb.FeatureGraphQL},
+			checksum:   bbb.ChecksumSHA256,
+		},
+		{
+			name:    "unknown",
+			missing: []bbb.Feature{bbb.FeatureInsertDocument, bbb.FeatureSHA256, bbb.Feature("teleport")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				body := `<response><returncode>SUCCESS</returncode><version>2.0</version><apiVersion>2.0</apiVersion>`
+				if tt.bbbVersion != "" {
+					body += `<bbbVersion>` + tt.bbbVersion + `</bbbVersion>`
+				}
+				if tt.graphqlURL != "" {
+					body += `<graphqlWebsocketUrl>` + tt.graphqlURL + `</graphqlWebsocketUrl>`
+				}
+				w.Write([]byte(body + `</response>`))
+			})
+
+			v, err := client.GetVersion(context.Background())
+			require.NoError(t, err)
+			for _, f := range tt.supported {
+				assert.True(t, v.Supports(f), f)
+			}
+			for _, f := range tt.missing {
+				assert.False(t, v.Supports(f), f)
+			}
+			alg, ok := v.ChecksumAlgorithm()
+			assert.Equal(t, tt.checksum != "", ok)
+			assert.Equal(t, tt.checksum, alg)
+		})
+	}
+}
@@ -8,9 +8,11 @@ package bbb
 import (
 	"context"
 	"net/url"
+	"strings"
 
 	"github.com/amirazad1/bigbluebutton-api-go/bbb/requests"
 	"github.com/amirazad1/bigbluebutton-api-go/bbb/responses"
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/webhooks"
 )
 
 // CreateHook creates a new webhook
@@ -23,6 +25,9 @@ func (c *Client) CreateHook(ctx context.Context, req *requests.CreateHookRequest
 	if req.CallbackURL == "" {
 		return nil, NewError(ErrMissingParam, "callbackURL is required")
 	}
+	if err := validateEventIDs(req.EventIDs); err != nil {
+		return nil, err
+	}
 
 	params := url.Values{
 		"callbackURL": {req.CallbackURL},
@@ -35,6 +40,9 @@ func (c *Client) CreateHook(ctx context.Context, req *requests.CreateHookRequest
 	if req.GetRaw {
 		params.Set("getRaw", "true")
 	}
+	if len(req.EventIDs) > 0 {
+		params.Set("eventID", strings.Join(req.EventIDs, ","))
+	}
 
 	// Add metadata
 	for k, v := range req.Meta {
@@ -98,18 +106,30 @@ func (c *Client) DestroyHook(ctx context.Context, hookID string) (*responses.Des
 }
 
 // UpdateHook updates an existing webhook
-func (c *Client) UpdateHook(ctx context.Context, hookID string, updates map[string]string) (*responses.CreateHookResponse, error) {
-	if hookID == "" {
+func (c *Client) UpdateHook(ctx context.Context, req *requests.UpdateHookRequest) (*responses.CreateHookResponse, error) {
+	if req == nil {
+		return nil, NewError(ErrInvalidParam, "request cannot be nil")
+	}
+
+	if req.HookID == "" {
 		return nil, NewError(ErrMissingParam, "hookID is required")
 	}
+	if err := validateEventIDs(req.EventIDs); err != nil {
+		return nil, err
+	}
 
 	params := url.Values{
-		"hookID": {hookID},
+		"hookID": {req.HookID},
 	}
 
-	// Add updates
-	for k, v := range updates {
-		params.Set(k, v)
+	setString(params, "callbackURL", req.CallbackURL)
+	if len(req.EventIDs) > 0 {
+		params.Set("eventID", strings.Join(req.EventIDs, ","))
+	}
+	setOptionalBool(params, "getRaw", req.GetRaw)
+
+	for k, v := range req.Meta {
+		params.Set("meta_"+k, v)
 	}
 
 	var response responses.CreateHookResponse
@@ -119,3 +139,13 @@ func (c *Client) UpdateHook(ctx context.Context, hookID string, updates map[stri
 
 	return &response, nil
 }
+
+// validateEventIDs checks that every event of a hook filter is an event bbb-webhooks sends.
+func validateEventIDs(ids []string) error {
+	for _, id := range ids {
+		if !webhooks.ValidEvent(id) {
+			return NewError(ErrInvalidParam, "unknown eventID: "+id)
+		}
+	}
+	return nil
+}
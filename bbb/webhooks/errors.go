@@ -0,0 +1,32 @@
+/*
+Package webhooks receives the events BigBlueButton posts to webhook callback URLs.
+This file defines the error reported when a callback request is rejected.
+*/
+
+package webhooks
+
+import "fmt"
+
+// Error describes why a webhook request was rejected or could not be handled.
+type Error struct {
+	Reason string
+	Event  string
+	Err    error
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	msg := "webhook: " + e.Reason
+	if e.Event != "" {
+		msg += fmt.Sprintf(" (event: %s)", e.Event)
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
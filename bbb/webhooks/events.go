@@ -0,0 +1,323 @@
+/*
+Package webhooks receives the events BigBlueButton posts to webhook callback URLs.
+This file defines the event types and the parser for the "event" form field.
+*/
+
+package webhooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Format identifies how an event is encoded.
+type Format string
+
+// Event formats sent by BigBlueButton, selected with getRaw when the hook is created.
+const (
+	FormatMapped Format = "mapped"
+	FormatRaw    Format = "raw"
+)
+
+// Event names of the mapped format.
+const (
+	EventMeetingCreated             = "meeting-created"
+	EventMeetingEnded               = "meeting-ended"
+	EventMeetingRecordingStarted    = "meeting-recording-started"
+	EventMeetingRecordingStopped    = "meeting-recording-stopped"
+	EventMeetingRecordingUnhandled  = "meeting-recording-unhandled"
+	EventMeetingScreenshareStarted  = "meeting-screenshare-started"
+	EventMeetingScreenshareStopped  = "meeting-screenshare-stopped"
+	EventMeetingPresentationChanged = "meeting-presentation-changed"
+
+	EventUserJoined               = "user-joined"
+	EventUserLeft                 = "user-left"
+	EventUserAudioVoiceEnabled    = "user-audio-voice-enabled"
+	EventUserAudioVoiceDisabled   = "user-audio-voice-disabled"
+	EventUserAudioMuted           = "user-audio-muted"
+	EventUserAudioUnmuted         = "user-audio-unmuted"
+	EventUserAudioListenOnlyStart = "user-audio-listen-only-enabled"
+	EventUserAudioListenOnlyEnd   = "user-audio-listen-only-disabled"
+	EventUserCamBroadcastStart    = "user-cam-broadcast-start"
+	EventUserCamBroadcastEnd      = "user-cam-broadcast-end"
+	EventUserPresenterAssigned    = "user-presenter-assigned"
+	EventUserPresenterUnassigned  = "user-presenter-unassigned"
+	EventUserEmojiChanged         = "user-emoji-changed"
+	EventUserRaiseHandChanged     = "user-raise-hand-changed"
+
+	EventChatGroupMessageSent = "chat-group-message-sent"
+	EventPollStarted          = "poll-started"
+	EventPollResponded        = "poll-responded"
+	EventPadContent           = "pad-content"
+
+	EventRapArchiveStarted     = "rap-archive-started"
+	EventRapArchiveEnded       = "rap-archive-ended"
+	EventRapSanityStarted      = "rap-sanity-started"
+	EventRapSanityEnded        = "rap-sanity-ended"
+	EventRapPostArchiveStarted = "rap-post-archive-started"
+	EventRapPostArchiveEnded   = "rap-post-archive-ended"
+	EventRapProcessStarted     = "rap-process-started"
+	EventRapProcessEnded       = "rap-process-ended"
+	EventRapPostProcessStarted = "rap-post-process-started"
+	EventRapPostProcessEnded   = "rap-post-process-ended"
+	EventRapPublishStarted     = "rap-publish-started"
+	EventRapPublishEnded       = "rap-publish-ended"
+	EventRapPostPublishStarted = "rap-post-publish-started"
+	EventRapPostPublishEnded   = "rap-post-publish-ended"
+	EventRapPublished          = "rap-published"
+	EventRapUnpublished        = "rap-unpublished"
+	EventRapDeleted            = "rap-deleted"
+)
+
+// serverEvents lists the events bbb-webhooks sends, including those without a
+// typed payload.
+var serverEvents = []string{
+	EventMeetingCreated, EventMeetingEnded, EventMeetingRecordingStarted, EventMeetingRecordingStopped,
+	EventMeetingRecordingUnhandled, EventMeetingScreenshareStarted, EventMeetingScreenshareStopped,
+	EventMeetingPresentationChanged,
+	EventUserJoined, EventUserLeft, EventUserAudioVoiceEnabled, EventUserAudioVoiceDisabled,
+	EventUserAudioMuted, EventUserAudioUnmuted, EventUserAudioListenOnlyStart, EventUserAudioListenOnlyEnd,
+	EventUserCamBroadcastStart, EventUserCamBroadcastEnd, EventUserPresenterAssigned,
+	EventUserPresenterUnassigned, EventUserEmojiChanged, EventUserRaiseHandChanged,
+	EventChatGroupMessageSent, EventPollStarted, EventPollResponded, EventPadContent,
+	EventRapArchiveStarted, EventRapArchiveEnded, EventRapSanityStarted, EventRapSanityEnded,
+	EventRapPostArchiveStarted, EventRapPostArchiveEnded, EventRapProcessStarted, EventRapProcessEnded,
+	EventRapPostProcessStarted, EventRapPostProcessEnded, EventRapPublishStarted, EventRapPublishEnded,
+	EventRapPostPublishStarted, EventRapPostPublishEnded, EventRapPublished, EventRapUnpublished,
+	EventRapDeleted,
+}
+
+// ValidEvent reports whether name is an event bbb-webhooks sends, and so a valid
+// hook filter. Case is ignored, as it is by bbb-webhooks. Unlike KnownEvent, it
+// does not require the event to have a typed payload.
+func ValidEvent(name string) bool {
+	for _, e := range serverEvents {
+		if strings.EqualFold(e, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is a single event received from BigBlueButton.
+type Event struct {
+	Name      string          // e.g. "user-joined", or "UserJoinedMeetingEvtMsg" for raw events
+	Format    Format          // Mapped or raw encoding
+	Timestamp time.Time       // When the event happened
+	MeetingID string          // Internal meeting ID, if known
+	Raw       json.RawMessage // The event exactly as received
+
+	attributes json.RawMessage
+}
+
+// Attributes returns the attributes object of a mapped event, or nil for raw events.
+func (e Event) Attributes() json.RawMessage {
+	return e.attributes
+}
+
+// Meeting identifies the meeting an event belongs to.
+type Meeting struct {
+	InternalMeetingID string            `json:"internal-meeting-id"`
+	ExternalMeetingID string            `json:"external-meeting-id"`
+	Name              string            `json:"name,omitempty"`
+	IsBreakout        bool              `json:"is-breakout,omitempty"`
+	Duration          int               `json:"duration,omitempty"`
+	CreateTime        int64             `json:"create-time,omitempty"`
+	CreateDate        string            `json:"create-date,omitempty"`
+	Record            bool              `json:"record,omitempty"`
+	VoiceConf         string            `json:"voice-conf,omitempty"`
+	DialNumber        string            `json:"dial-number,omitempty"`
+	MaxUsers          int               `json:"max-users,omitempty"`
+	Metadata          map[string]string `json:"metadata,omitempty"`
+}
+
+// UnmarshalJSON decodes a meeting, also accepting the "id" and "recorded"
+// keys of the header/payload format.
+func (m *Meeting) UnmarshalJSON(data []byte) error {
+	type meeting Meeting
+	var aux struct {
+		meeting
+		ID       string `json:"id"`
+		Recorded *bool  `json:"recorded"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*m = Meeting(aux.meeting)
+	if m.ExternalMeetingID == "" {
+		m.ExternalMeetingID = aux.ID
+	}
+	if aux.Recorded != nil {
+		m.Record = *aux.Recorded
+	}
+	return nil
+}
+
+// User identifies the user an event refers to.
+type User struct {
+	InternalUserID string            `json:"internal-user-id"`
+	ExternalUserID string            `json:"external-user-id"`
+	Name           string            `json:"name,omitempty"`
+	Role           string            `json:"role,omitempty"`
+	Presenter      bool              `json:"presenter,omitempty"`
+	Stream         string            `json:"stream,omitempty"`
+	SharingMic     bool              `json:"sharing-mic,omitempty"`
+	ListeningOnly  bool              `json:"listening-only,omitempty"`
+	Muted          bool              `json:"muted,omitempty"`
+	Emoji          string            `json:"emoji,omitempty"`
+	RaiseHand      bool              `json:"raise-hand,omitempty"`
+	UserData       map[string]string `json:"userdata,omitempty"`
+}
+
+// mappedEvent is the JSON shape of an event in the mapped format.
+type mappedEvent struct {
+	Data struct {
+		Type       string          `json:"type"`
+		ID         string          `json:"id"`
+		Attributes json.RawMessage `json:"attributes"`
+		Event      struct {
+			TS int64 `json:"ts"`
+		} `json:"event"`
+	} `json:"data"`
+}
+
+// headerPayloadEvent is the JSON shape of an event with a header and a payload object.
+type headerPayloadEvent struct {
+	Header struct {
+		Name      string          `json:"name"`
+		Timestamp json.RawMessage `json:"timestamp"`
+	} `json:"header"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// rawEvent is the JSON shape of an event in the raw format.
+type rawEvent struct {
+	Envelope struct {
+		Name      string `json:"name"`
+		Timestamp int64  `json:"timestamp"`
+	} `json:"envelope"`
+	Core struct {
+		Header struct {
+			Name      string `json:"name"`
+			MeetingID string `json:"meetingId"`
+		} `json:"header"`
+	} `json:"core"`
+}
+
+// ParseEvents parses the "event" form field, a JSON array of events in the mapped
+// or raw format. A single event object is accepted as well, and so are events
+// made of a header and a payload, which are treated as mapped events.
+func ParseEvents(field string) ([]Event, error) {
+	data := bytes.TrimSpace([]byte(field))
+	if len(data) == 0 {
+		return nil, fmt.Errorf("parsing events: empty event field")
+	}
+
+	var items []json.RawMessage
+	if data[0] == '[' {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, fmt.Errorf("parsing events: %w", err)
+		}
+	} else {
+		items = []json.RawMessage{data}
+	}
+
+	events := make([]Event, 0, len(items))
+	for i, item := range items {
+		e, err := parseEvent(item)
+		if err != nil {
+			return nil, fmt.Errorf("parsing event %d: %w", i, err)
+		}
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+// parseEvent detects the format of a single event and decodes its common fields.
+func parseEvent(data json.RawMessage) (Event, error) {
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return Event{}, err
+	}
+
+	switch {
+	case probe["data"] != nil:
+		var m mappedEvent
+		if err := json.Unmarshal(data, &m); err != nil {
+			return Event{}, err
+		}
+		return newMappedEvent(m.Data.ID, time.UnixMilli(m.Data.Event.TS), m.Data.Attributes, data), nil
+
+	case probe["header"] != nil && probe["payload"] != nil:
+		var hp headerPayloadEvent
+		if err := json.Unmarshal(data, &hp); err != nil {
+			return Event{}, err
+		}
+		ts, err := parseTimestamp(hp.Header.Timestamp)
+		if err != nil {
+			return Event{}, err
+		}
+		return newMappedEvent(hp.Header.Name, ts, hp.Payload, data), nil
+
+	case probe["envelope"] != nil || probe["core"] != nil:
+		var r rawEvent
+		if err := json.Unmarshal(data, &r); err != nil {
+			return Event{}, err
+		}
+		name := r.Envelope.Name
+		if name == "" {
+			name = r.Core.Header.Name
+		}
+		return Event{
+			Name:      name,
+			Format:    FormatRaw,
+			Timestamp: time.UnixMilli(r.Envelope.Timestamp),
+			MeetingID: r.Core.Header.MeetingID,
+			Raw:       data,
+		}, nil
+	}
+
+	return Event{}, fmt.Errorf("unrecognized event format")
+}
+
+// newMappedEvent builds a mapped event from its name, time and attributes.
+func newMappedEvent(name string, ts time.Time, attributes, data json.RawMessage) Event {
+	e := Event{
+		Name:       name,
+		Format:     FormatMapped,
+		Timestamp:  ts,
+		Raw:        data,
+		attributes: attributes,
+	}
+	var attrs struct {
+		Meeting Meeting `json:"meeting"`
+	}
+	if len(attributes) > 0 && json.Unmarshal(attributes, &attrs) == nil {
+		e.MeetingID = attrs.Meeting.InternalMeetingID
+	}
+	return e
+}
+
+// parseTimestamp parses a timestamp given either in milliseconds or as an RFC 3339 string.
+func parseTimestamp(data json.RawMessage) (time.Time, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return time.Time{}, nil
+	}
+	var ms int64
+	if err := json.Unmarshal(data, &ms); err == nil {
+		return time.UnixMilli(ms), nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp: %s", data)
+	}
+	ts, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp: %w", err)
+	}
+	return ts, nil
+}
@@ -0,0 +1,261 @@
+/*
+Package webhooks receives the events BigBlueButton posts to webhook callback URLs.
+This file contains the http.Handler that verifies and dispatches incoming events.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"net/http"
+	"strings"
+)
+
+// Handler is an http.Handler for webhook callbacks. It verifies that requests
+// were signed with the shared secret, parses the posted events and calls the
+// callback matching each event. Callbacks are optional; returning an error
+// from one answers with a 500 status so BigBlueButton retries the delivery.
+// An event whose payload cannot be decoded is reported to OnError and
+// acknowledged, since a retry would fail the same way.
+type Handler struct {
+	// Secret is the BigBlueButton shared secret. Requests are rejected when it is
+	// empty, unless InsecureSkipVerify is set.
+	Secret string
+
+	// InsecureSkipVerify accepts requests without checking their signature, so
+	// anyone who can reach the handler can post forged events. Only use it when
+	// the callback URL is unreachable from outside, e.g. in tests.
+	InsecureSkipVerify bool
+
+	// CallbackURL is the URL the hook was registered with. It is part of the signed
+	// data, so set it when the handler runs behind a proxy that rewrites the URL.
+	// If empty, it is rebuilt from the incoming request.
+	CallbackURL string
+
+	// OnEvent is called for every event, before any other callback.
+	OnEvent func(ctx context.Context, e Event) error
+
+	// OnPayload is called for every event with its typed payload; see Decode.
+	OnPayload func(ctx context.Context, e Event, p Payload) error
+
+	// Callbacks for common events, called with their typed payload after OnPayload.
+	OnMeetingCreated func(ctx context.Context, e Event, p *MeetingCreated) error
+	OnMeetingEnded   func(ctx context.Context, e Event, p *MeetingEnded) error
+	OnUserJoined     func(ctx context.Context, e Event, p *UserJoined) error
+	OnUserLeft       func(ctx context.Context, e Event, p *UserLeft) error
+
+	// OnError is called when a request is rejected or a callback fails.
+	OnError func(r *http.Request, err error)
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		h.fail(w, r, http.StatusBadRequest, &Error{Reason: "invalid form", Err: err})
+		return
+	}
+
+	if h.Secret == "" && !h.InsecureSkipVerify {
+		h.fail(w, r, http.StatusUnauthorized, &Error{Reason: "no secret configured"})
+		return
+	}
+	if !h.verify(r) {
+		h.fail(w, r, http.StatusUnauthorized, &Error{Reason: "checksum mismatch"})
+		return
+	}
+
+	events, err := ParseEvents(r.PostForm.Get("event"))
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, &Error{Reason: "invalid event", Err: err})
+		return
+	}
+
+	for _, e := range events {
+		if err := h.dispatch(r, e); err != nil {
+			h.fail(w, r, http.StatusInternalServerError, &Error{Reason: "callback failed", Event: e.Name, Err: err})
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatch calls the callbacks registered for an event and returns the first
+// callback error. An event whose payload cannot be decoded is reported and
+// skipped by the payload callbacks.
+func (h *Handler) dispatch(r *http.Request, e Event) error {
+	ctx := r.Context()
+	if h.OnEvent != nil {
+		if err := h.OnEvent(ctx, e); err != nil {
+			return err
+		}
+	}
+
+	if !h.wantsPayload() {
+		return nil
+	}
+	p, err := Decode(e)
+	if err != nil {
+		h.report(r, &Error{Reason: "invalid payload", Event: e.Name, Err: err})
+		return nil
+	}
+
+	if h.OnPayload != nil {
+		if err := h.OnPayload(ctx, e, p); err != nil {
+			return err
+		}
+	}
+
+	switch p := p.(type) {
+	case *MeetingCreated:
+		if h.OnMeetingCreated != nil {
+			return h.OnMeetingCreated(ctx, e, p)
+		}
+	case *MeetingEnded:
+		if h.OnMeetingEnded != nil {
+			return h.OnMeetingEnded(ctx, e, p)
+		}
+	case *UserJoined:
+		if h.OnUserJoined != nil {
+			return h.OnUserJoined(ctx, e, p)
+		}
+	case *UserLeft:
+		if h.OnUserLeft != nil {
+			return h.OnUserLeft(ctx, e, p)
+		}
+	}
+
+	return nil
+}
+
+// wantsPayload reports whether a callback needs the typed payload of events.
+func (h *Handler) wantsPayload() bool {
+	return h.OnPayload != nil || h.OnMeetingCreated != nil || h.OnMeetingEnded != nil ||
+		h.OnUserJoined != nil || h.OnUserLeft != nil
+}
+
+// fail reports an error and writes the status code.
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	h.report(r, err)
+	http.Error(w, http.StatusText(status), status)
+}
+
+// report passes an error to OnError, if set.
+func (h *Handler) report(r *http.Request, err error) {
+	if h.OnError != nil {
+		h.OnError(r, err)
+	}
+}
+
+// verify checks the request against the shared secret, accepting either a
+// bearer token or the checksum query parameter added by bbb-webhooks.
+func (h *Handler) verify(r *http.Request) bool {
+	if h.InsecureSkipVerify {
+		return true
+	}
+	if h.Secret == "" {
+		return false
+	}
+
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, "Bearer ")), []byte(h.Secret)) == 1
+	}
+
+	checksum := strings.ToLower(r.URL.Query().Get("checksum"))
+	newHash := hashForChecksum(checksum)
+	if newHash == nil {
+		return false
+	}
+
+	sum := newHash()
+	sum.Write([]byte(h.callbackURL(r) + signedData(r) + h.Secret))
+	expected := hex.EncodeToString(sum.Sum(nil))
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(checksum)) == 1
+}
+
+// callbackURL returns the URL the hook was registered with, without the checksum parameter.
+func (h *Handler) callbackURL(r *http.Request) string {
+	if h.CallbackURL != "" {
+		return h.CallbackURL
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+
+	u := scheme + "://" + r.Host + r.URL.Path
+	if query := stripChecksum(r.URL.RawQuery); query != "" {
+		u += "?" + query
+	}
+	return u
+}
+
+// stripChecksum removes the checksum parameter from a raw query, keeping the order of the others.
+func stripChecksum(rawQuery string) string {
+	parts := strings.Split(rawQuery, "&")
+	kept := parts[:0]
+	for _, p := range parts {
+		if p != "" && !strings.HasPrefix(p, "checksum=") {
+			kept = append(kept, p)
+		}
+	}
+	return strings.Join(kept, "&")
+}
+
+// signedData rebuilds the JSON document bbb-webhooks signs: the posted form
+// fields in the order event, timestamp, domain.
+func signedData(r *http.Request) string {
+	var b strings.Builder
+	b.WriteString(`{"event":`)
+	b.WriteString(jsonString(r.PostForm.Get("event")))
+	b.WriteString(`,"timestamp":`)
+	b.WriteString(r.PostForm.Get("timestamp"))
+	if r.PostForm.Has("domain") {
+		b.WriteString(`,"domain":`)
+		b.WriteString(jsonString(r.PostForm.Get("domain")))
+	}
+	b.WriteString(`}`)
+	return b.String()
+}
+
+// jsonString encodes s the way JavaScript's JSON.stringify does, without HTML escaping.
+func jsonString(s string) string {
+	var b strings.Builder
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// hashForChecksum selects the hash function matching the length of a hex checksum.
+func hashForChecksum(checksum string) func() hash.Hash {
+	switch len(checksum) {
+	case 40:
+		return sha1.New
+	case 64:
+		return sha256.New
+	case 96:
+		return sha512.New384
+	case 128:
+		return sha512.New
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package webhooks_test
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/webhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSecret      = "test-secret"
+	testCallbackURL = "http://example.com/hooks?tenant=1"
+)
+
+const mappedEvents = `[` +
+	`{"data":{"type":"event","id":"meeting-created","attributes":{"meeting":{"internal-meeting-id":"abc-123","external-meeting-id":"test123","name":"Test <Meeting>","is-breakout":false,"record":true,"metadata":{"course":"math-101"}}},"event":{"ts":1502810164922}}},` +
+	`{"data":{"type":"event","id":"user-joined","attributes":{"meeting":{"internal-meeting-id":"abc-123","external-meeting-id":"test123"},"user":{"internal-user-id":"w_abc","external-user-id":"user-1","name":"Jane Doe","role":"MODERATOR","presenter":true,"userdata":{"course":"math-101"}}},"event":{"ts":1502810165000}}}` +
+	`]`
+
+// newWebhookRequest builds a request signed the way bbb-webhooks signs callbacks.
+func newWebhookRequest(t *testing.T, event string) *http.Request {
+	t.Helper()
+
+	data := `{"event":` + quote(event) + `,"timestamp":1502810165000,"domain":"bbb.example.com"}`
+	sum := sha1.Sum([]byte(testCallbackURL + data + testSecret))
+
+	form := url.Values{
+		"event":     {event},
+		"timestamp": {"1502810165000"},
+		"domain":    {"bbb.example.com"},
+	}
+	req := httptest.NewRequest(http.MethodPost, testCallbackURL+"&checksum="+hex.EncodeToString(sum[:]), strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+// quote encodes a string as a JSON string without HTML escaping.
+func quote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// -------------------- Handler --------------------
+
+func TestHandler_DispatchesMappedEvents(t *testing.T) {
+	var names []string
+	var createdEvent webhooks.Event
+	var created *webhooks.MeetingCreated
+	var joined *webhooks.UserJoined
+
+	h := &webhooks.Handler{
+		Secret: testSecret,
+		OnEvent: func(ctx context.Context, e webhooks.Event) error {
+			names = append(names, e.Name)
+			return nil
+		},
+		OnMeetingCreated: func(ctx context.Context, e webhooks.Event, p *webhooks.MeetingCreated) error {
+			createdEvent, created = e, p
+			return nil
+		},
+		OnUserJoined: func(ctx context.Context, e webhooks.Event, p *webhooks.UserJoined) error {
+			joined = p
+			return nil
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, mappedEvents))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{"meeting-created", "user-joined"}, names)
+
+	require.NotNil(t, created)
+	assert.Equal(t, webhooks.FormatMapped, createdEvent.Format)
+	assert.Equal(t, "abc-123", createdEvent.MeetingID)
+	assert.Equal(t, "test123", created.Meeting.ExternalMeetingID)
+	assert.Equal(t, "Test <Meeting>", created.Meeting.Name)
+	assert.True(t, created.Meeting.Record)
+	assert.Equal(t, "math-101", created.Meeting.Metadata["course"])
+	assert.Equal(t, int64(1502810164922), createdEvent.Timestamp.UnixMilli())
+
+	require.NotNil(t, joined)
+	assert.Equal(t, "w_abc", joined.User.InternalUserID)
+	assert.Equal(t, "Jane Doe", joined.User.Name)
+	assert.True(t, joined.User.Presenter)
+	assert.Equal(t, "test123", joined.Meeting.ExternalMeetingID)
+}
+
+func TestHandler_RawEvents(t *testing.T) {
+	raw := `[{"envelope":{"name":"UserJoinedMeetingEvtMsg","timestamp":1502810165000},"core":{"header":{"name":"UserJoinedMeetingEvtMsg","meetingId":"abc-123"},"body":{"intId":"w_abc","name":"Jane Doe"}}}]`
+
+	var got webhooks.Event
+	h := &webhooks.Handler{
+		Secret: testSecret,
+		OnEvent: func(ctx context.Context, e webhooks.Event) error {
+			got = e
+			return nil
+		},
+		OnUserJoined: func(ctx context.Context, e webhooks.Event, p *webhooks.UserJoined) error {
+			t.Error("typed callbacks are only called for mapped events")
+			return nil
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, raw))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, webhooks.FormatRaw, got.Format)
+	assert.Equal(t, "UserJoinedMeetingEvtMsg", got.Name)
+	assert.Equal(t, "abc-123", got.MeetingID)
+	assert.JSONEq(t, raw[1:len(raw)-1], string(got.Raw))
+}
+
+func TestHandler_RejectsBadChecksum(t *testing.T) {
+	var rejected error
+	h := &webhooks.Handler{
+		Secret:  "other-secret",
+		OnError: func(r *http.Request, err error) { rejected = err },
+		OnEvent: func(ctx context.Context, e webhooks.Event) error {
+			t.Error("unexpected event")
+			return nil
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, mappedEvents))
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Error(t, rejected)
+	assert.Contains(t, rejected.Error(), "checksum mismatch")
+}
+
+func TestHandler_NoSecret(t *testing.T) {
+	var rejected error
+	h := &webhooks.Handler{OnError: func(r *http.Request, err error) { rejected = err }}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, mappedEvents))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.ErrorContains(t, rejected, "no secret configured")
+
+	h.InsecureSkipVerify = true
+	form := url.Values{"event": {mappedEvents}, "timestamp": {"1"}}
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/hooks", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestHandler_ConfiguredCallbackURL(t *testing.T) {
+	h := &webhooks.Handler{Secret: testSecret, CallbackURL: testCallbackURL}
+
+	req := newWebhookRequest(t, mappedEvents)
+	req.Host = "internal-proxy:8080"
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestHandler_BearerToken(t *testing.T) {
+	h := &webhooks.Handler{Secret: testSecret}
+
+	form := url.Values{"event": {mappedEvents}, "timestamp": {"1"}}
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/hooks", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+testSecret)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestHandler_CallbackError(t *testing.T) {
+	h := &webhooks.Handler{
+		Secret: testSecret,
+		OnUserJoined: func(ctx context.Context, e webhooks.Event, p *webhooks.UserJoined) error {
+			return errors.New("database unavailable")
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, mappedEvents))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestHandler_InvalidPayload(t *testing.T) {
+	events := `[` +
+		`{"data":{"type":"event","id":"user-joined","attributes":{"meeting":{"internal-meeting-id":"abc-123"},"user":"w_abc"},"event":{"ts":1}}},` +
+		`{"data":{"type":"event","id":"user-left","attributes":{"meeting":{"internal-meeting-id":"abc-123"},"user":{"internal-user-id":"w_abc"}},"event":{"ts":2}}}` +
+		`]`
+
+	var reported error
+	var left []string
+	h := &webhooks.Handler{
+		Secret:  testSecret,
+		OnError: func(r *http.Request, err error) { reported = err },
+		OnUserJoined: func(ctx context.Context, e webhooks.Event, p *webhooks.UserJoined) error {
+			t.Error("an event that cannot be decoded is skipped")
+			return nil
+		},
+		OnUserLeft: func(ctx context.Context, e webhooks.Event, p *webhooks.UserLeft) error {
+			left = append(left, p.User.InternalUserID)
+			return nil
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, events))
+
+	assert.Equal(t, http.StatusOK, rec.Code, "a retry would fail the same way")
+	assert.ErrorContains(t, reported, "invalid payload (event: user-joined)")
+	assert.Equal(t, []string{"w_abc"}, left)
+}
+
+func TestHandler_InvalidRequests(t *testing.T) {
+	h := &webhooks.Handler{Secret: testSecret}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, testCallbackURL, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, `[{"unexpected":true}]`))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+// -------------------- ParseEvents --------------------
+
+func TestParseEvents_SingleObject(t *testing.T) {
+	events, err := webhooks.ParseEvents(`{"data":{"type":"event","id":"meeting-ended","attributes":{"meeting":{"internal-meeting-id":"abc-123"}},"event":{"ts":1}}}`)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "meeting-ended", events[0].Name)
+	assert.Equal(t, "abc-123", events[0].MeetingID)
+
+	_, err = webhooks.ParseEvents("")
+	require.Error(t, err)
+}
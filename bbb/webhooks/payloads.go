@@ -0,0 +1,280 @@
+/*
+Package webhooks receives the events BigBlueButton posts to webhook callback URLs.
+This file defines the typed payload of every mapped event and the decoder that
+turns an Event into one of them.
+*/
+
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Payload is the typed content of an event. Use a type switch on the concrete
+// type, e.g. *UserJoined or *RapPublishEnded. Events without a known type,
+// including every raw-format event, decode to *Unknown.
+type Payload interface {
+	EventName() string
+}
+
+// MeetingPayload holds the attributes shared by meeting events.
+type MeetingPayload struct {
+	Meeting Meeting `json:"meeting"`
+}
+
+// UserPayload holds the attributes shared by user events.
+type UserPayload struct {
+	Meeting Meeting `json:"meeting"`
+	User    User    `json:"user"`
+}
+
+// RecordingPayload holds the attributes shared by recording processing (rap-*) events.
+type RecordingPayload struct {
+	Meeting   Meeting        `json:"meeting"`
+	RecordID  string         `json:"record-id"`
+	Success   bool           `json:"success"`
+	StepTime  int64          `json:"step-time"` // Milliseconds spent in the step
+	Workflow  string         `json:"workflow"`  // e.g. "presentation" or "video"
+	Recording *RecordingInfo `json:"recording,omitempty"`
+}
+
+// RecordingInfo describes a recording in rap-* events.
+type RecordingInfo struct {
+	Name       string             `json:"name"`
+	IsBreakout bool               `json:"is-breakout"`
+	StartTime  int64              `json:"start-time"`
+	EndTime    int64              `json:"end-time"`
+	Size       int64              `json:"size"`
+	RawSize    int64              `json:"raw-size"`
+	Metadata   map[string]string  `json:"metadata,omitempty"`
+	Playback   *RecordingPlayback `json:"playback,omitempty"`
+	Download   *RecordingPlayback `json:"download,omitempty"`
+}
+
+// RecordingPlayback describes a published playback format of a recording.
+type RecordingPlayback struct {
+	Format         string          `json:"format"`
+	Link           string          `json:"link"`
+	ProcessingTime int64           `json:"processing-time"`
+	Duration       int64           `json:"duration"`
+	Extensions     json.RawMessage `json:"extensions,omitempty"`
+}
+
+// ChatMessage is a message sent to a chat.
+type ChatMessage struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	Sender  struct {
+		InternalUserID string `json:"internal-user-id"`
+		ExternalUserID string `json:"external-user-id"`
+		Name           string `json:"name"`
+		Time           int64  `json:"time"`
+	} `json:"sender"`
+}
+
+// Poll describes a poll started or answered in a meeting.
+type Poll struct {
+	ID       string `json:"id"`
+	Question string `json:"question,omitempty"`
+	Answers  []struct {
+		ID  int    `json:"id"`
+		Key string `json:"key"`
+	} `json:"answers,omitempty"`
+	AnswerIDs []int `json:"answerIds,omitempty"`
+}
+
+// Meeting events.
+type (
+	MeetingCreated             struct{ MeetingPayload }
+	MeetingEnded               struct{ MeetingPayload }
+	MeetingRecordingStarted    struct{ MeetingPayload }
+	MeetingRecordingStopped    struct{ MeetingPayload }
+	MeetingRecordingUnhandled  struct{ MeetingPayload }
+	MeetingScreenshareStarted  struct{ MeetingPayload }
+	MeetingScreenshareStopped  struct{ MeetingPayload }
+	MeetingPresentationChanged struct {
+		MeetingPayload
+		PresentationID string `json:"presentation-id"`
+	}
+)
+
+// User events.
+type (
+	UserJoined               struct{ UserPayload }
+	UserLeft                 struct{ UserPayload }
+	UserAudioVoiceEnabled    struct{ UserPayload }
+	UserAudioVoiceDisabled   struct{ UserPayload }
+	UserAudioMuted           struct{ UserPayload }
+	UserAudioUnmuted         struct{ UserPayload }
+	UserCamBroadcastStart    struct{ UserPayload }
+	UserCamBroadcastEnd      struct{ UserPayload }
+	UserPresenterAssigned    struct{ UserPayload }
+	UserPresenterUnassigned  struct{ UserPayload }
+	UserEmojiChanged         struct{ UserPayload }
+	UserRaiseHandChanged     struct{ UserPayload }
+	UserAudioListenOnlyStart struct{ UserPayload }
+	UserAudioListenOnlyEnd   struct{ UserPayload }
+)
+
+// Chat and poll events.
+type (
+	ChatGroupMessageSent struct {
+		Meeting Meeting     `json:"meeting"`
+		ChatID  string      `json:"chat-id"`
+		Message ChatMessage `json:"chat-message"`
+	}
+	PollStarted struct {
+		UserPayload
+		Poll Poll `json:"poll"`
+	}
+	PollResponded struct {
+		UserPayload
+		Poll Poll `json:"poll"`
+	}
+)
+
+// Recording processing events.
+type (
+	RapArchiveStarted     struct{ RecordingPayload }
+	RapArchiveEnded       struct{ RecordingPayload }
+	RapSanityStarted      struct{ RecordingPayload }
+	RapSanityEnded        struct{ RecordingPayload }
+	RapPostArchiveStarted struct{ RecordingPayload }
+	RapPostArchiveEnded   struct{ RecordingPayload }
+	RapProcessStarted     struct{ RecordingPayload }
+	RapProcessEnded       struct{ RecordingPayload }
+	RapPostProcessStarted struct{ RecordingPayload }
+	RapPostProcessEnded   struct{ RecordingPayload }
+	RapPublishStarted     struct{ RecordingPayload }
+	RapPublishEnded       struct{ RecordingPayload }
+	RapPostPublishStarted struct{ RecordingPayload }
+	RapPostPublishEnded   struct{ RecordingPayload }
+	RapPublished          struct{ RecordingPayload }
+	RapUnpublished        struct{ RecordingPayload }
+	RapDeleted            struct{ RecordingPayload }
+)
+
+// Unknown is an event without a typed payload, kept as received.
+type Unknown struct {
+	Name string
+	Raw  json.RawMessage
+}
+
+func (*MeetingCreated) EventName() string             { return EventMeetingCreated }
+func (*MeetingEnded) EventName() string               { return EventMeetingEnded }
+func (*MeetingRecordingStarted) EventName() string    { return EventMeetingRecordingStarted }
+func (*MeetingRecordingStopped) EventName() string    { return EventMeetingRecordingStopped }
+func (*MeetingRecordingUnhandled) EventName() string  { return EventMeetingRecordingUnhandled }
+func (*MeetingScreenshareStarted) EventName() string  { return EventMeetingScreenshareStarted }
+func (*MeetingScreenshareStopped) EventName() string  { return EventMeetingScreenshareStopped }
+func (*MeetingPresentationChanged) EventName() string { return EventMeetingPresentationChanged }
+func (*UserJoined) EventName() string                 { return EventUserJoined }
+func (*UserLeft) EventName() string                   { return EventUserLeft }
+func (*UserAudioVoiceEnabled) EventName() string      { return EventUserAudioVoiceEnabled }
+func (*UserAudioVoiceDisabled) EventName() string     { return EventUserAudioVoiceDisabled }
+func (*UserAudioMuted) EventName() string             { return EventUserAudioMuted }
+func (*UserAudioUnmuted) EventName() string           { return EventUserAudioUnmuted }
+func (*UserAudioListenOnlyStart) EventName() string   { return EventUserAudioListenOnlyStart }
+func (*UserAudioListenOnlyEnd) EventName() string     { return EventUserAudioListenOnlyEnd }
+func (*UserCamBroadcastStart) EventName() string      { return EventUserCamBroadcastStart }
+func (*UserCamBroadcastEnd) EventName() string        { return EventUserCamBroadcastEnd }
+func (*UserPresenterAssigned) EventName() string      { return EventUserPresenterAssigned }
+func (*UserPresenterUnassigned) EventName() string    { return EventUserPresenterUnassigned }
+func (*UserEmojiChanged) EventName() string           { return EventUserEmojiChanged }
+func (*UserRaiseHandChanged) EventName() string       { return EventUserRaiseHandChanged }
+func (*ChatGroupMessageSent) EventName() string       { return EventChatGroupMessageSent }
+func (*PollStarted) EventName() string                { return EventPollStarted }
+func (*PollResponded) EventName() string              { return EventPollResponded }
+func (*RapArchiveStarted) EventName() string          { return EventRapArchiveStarted }
+func (*RapArchiveEnded) EventName() string            { return EventRapArchiveEnded }
+func (*RapSanityStarted) EventName() string           { return EventRapSanityStarted }
+func (*RapSanityEnded) EventName() string             { return EventRapSanityEnded }
+func (*RapPostArchiveStarted) EventName() string      { return EventRapPostArchiveStarted }
+func (*RapPostArchiveEnded) EventName() string        { return EventRapPostArchiveEnded }
+func (*RapProcessStarted) EventName() string          { return EventRapProcessStarted }
+func (*RapProcessEnded) EventName() string            { return EventRapProcessEnded }
+func (*RapPostProcessStarted) EventName() string      { return EventRapPostProcessStarted }
+func (*RapPostProcessEnded) EventName() string        { return EventRapPostProcessEnded }
+func (*RapPublishStarted) EventName() string          { return EventRapPublishStarted }
+func (*RapPublishEnded) EventName() string            { return EventRapPublishEnded }
+func (*RapPostPublishStarted) EventName() string      { return EventRapPostPublishStarted }
+func (*RapPostPublishEnded) EventName() string        { return EventRapPostPublishEnded }
+func (*RapPublished) EventName() string               { return EventRapPublished }
+func (*RapUnpublished) EventName() string             { return EventRapUnpublished }
+func (*RapDeleted) EventName() string                 { return EventRapDeleted }
+func (u *Unknown) EventName() string                  { return u.Name }
+
+// payloadTypes maps event names to constructors of their typed payloads.
+var payloadTypes = map[string]func() Payload{
+	EventMeetingCreated:             func() Payload { return &MeetingCreated{} },
+	EventMeetingEnded:               func() Payload { return &MeetingEnded{} },
+	EventMeetingRecordingStarted:    func() Payload { return &MeetingRecordingStarted{} },
+	EventMeetingRecordingStopped:    func() Payload { return &MeetingRecordingStopped{} },
+	EventMeetingRecordingUnhandled:  func() Payload { return &MeetingRecordingUnhandled{} },
+	EventMeetingScreenshareStarted:  func() Payload { return &MeetingScreenshareStarted{} },
+	EventMeetingScreenshareStopped:  func() Payload { return &MeetingScreenshareStopped{} },
+	EventMeetingPresentationChanged: func() Payload { return &MeetingPresentationChanged{} },
+	EventUserJoined:                 func() Payload { return &UserJoined{} },
+	EventUserLeft:                   func() Payload { return &UserLeft{} },
+	EventUserAudioVoiceEnabled:      func() Payload { return &UserAudioVoiceEnabled{} },
+	EventUserAudioVoiceDisabled:     func() Payload { return &UserAudioVoiceDisabled{} },
+	EventUserAudioMuted:             func() Payload { return &UserAudioMuted{} },
+	EventUserAudioUnmuted:           func() Payload { return &UserAudioUnmuted{} },
+	EventUserAudioListenOnlyStart:   func() Payload { return &UserAudioListenOnlyStart{} },
+	EventUserAudioListenOnlyEnd:     func() Payload { return &UserAudioListenOnlyEnd{} },
+	EventUserCamBroadcastStart:      func() Payload { return &UserCamBroadcastStart{} },
+	EventUserCamBroadcastEnd:        func() Payload { return &UserCamBroadcastEnd{} },
+	EventUserPresenterAssigned:      func() Payload { return &UserPresenterAssigned{} },
+	EventUserPresenterUnassigned:    func() Payload { return &UserPresenterUnassigned{} },
+	EventUserEmojiChanged:           func() Payload { return &UserEmojiChanged{} },
+	EventUserRaiseHandChanged:       func() Payload { return &UserRaiseHandChanged{} },
+	EventChatGroupMessageSent:       func() Payload { return &ChatGroupMessageSent{} },
+	EventPollStarted:                func() Payload { return &PollStarted{} },
+	EventPollResponded:              func() Payload { return &PollResponded{} },
+	EventRapArchiveStarted:          func() Payload { return &RapArchiveStarted{} },
+	EventRapArchiveEnded:            func() Payload { return &RapArchiveEnded{} },
+	EventRapSanityStarted:           func() Payload { return &RapSanityStarted{} },
+	EventRapSanityEnded:             func() Payload { return &RapSanityEnded{} },
+	EventRapPostArchiveStarted:      func() Payload { return &RapPostArchiveStarted{} },
+	EventRapPostArchiveEnded:        func() Payload { return &RapPostArchiveEnded{} },
+	EventRapProcessStarted:          func() Payload { return &RapProcessStarted{} },
+	EventRapProcessEnded:            func() Payload { return &RapProcessEnded{} },
+	EventRapPostProcessStarted:      func() Payload { return &RapPostProcessStarted{} },
+	EventRapPostProcessEnded:        func() Payload { return &RapPostProcessEnded{} },
+	EventRapPublishStarted:          func() Payload { return &RapPublishStarted{} },
+	EventRapPublishEnded:            func() Payload { return &RapPublishEnded{} },
+	EventRapPostPublishStarted:      func() Payload { return &RapPostPublishStarted{} },
+	EventRapPostPublishEnded:        func() Payload { return &RapPostPublishEnded{} },
+	EventRapPublished:               func() Payload { return &RapPublished{} },
+	EventRapUnpublished:             func() Payload { return &RapUnpublished{} },
+	EventRapDeleted:                 func() Payload { return &RapDeleted{} },
+}
+
+// KnownEvent reports whether name is an event with a typed payload.
+func KnownEvent(name string) bool {
+	_, ok := payloadTypes[name]
+	return ok
+}
+
+// Decode returns the typed payload of an event. Raw-format events and events
+// without a typed payload are returned as *Unknown.
+func Decode(e Event) (Payload, error) {
+	newPayload, ok := payloadTypes[e.Name]
+	if !ok || e.Format != FormatMapped {
+		return &Unknown{Name: e.Name, Raw: e.Raw}, nil
+	}
+
+	p := newPayload()
+	if len(e.attributes) > 0 {
+		if err := json.Unmarshal(e.attributes, p); err != nil {
+			return nil, fmt.Errorf("decoding %s attributes: %w", e.Name, err)
+		}
+	}
+	return p, nil
+}
+
+// Payload returns the typed payload of the event. See Decode.
+func (e Event) Payload() (Payload, error) {
+	return Decode(e)
+}
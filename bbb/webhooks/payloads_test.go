@@ -0,0 +1,155 @@
+package webhooks_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb/webhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// parseOne parses a single event and decodes its payload.
+func parseOne(t *testing.T, field string) (webhooks.Event, webhooks.Payload) {
+	t.Helper()
+
+	events, err := webhooks.ParseEvents(field)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	p, err := events[0].Payload()
+	require.NoError(t, err)
+	return events[0], p
+}
+
+// -------------------- Decode --------------------
+
+func TestDecode_UserEvents(t *testing.T) {
+	tests := []struct {
+		name  string
+		event string
+		check func(t *testing.T, p webhooks.Payload)
+	}{
+		{
+			name:  "audio voice enabled",
+			event: `{"data":{"type":"event","id":"user-audio-voice-enabled","attributes":{"meeting":{"internal-meeting-id":"abc-123"},"user":{"internal-user-id":"w_1","sharing-mic":true,"listening-only":false,"muted":false}},"event":{"ts":1}}}`,
+			check: func(t *testing.T, p webhooks.Payload) {
+				e, ok := p.(*webhooks.UserAudioVoiceEnabled)
+				require.True(t, ok)
+				assert.Equal(t, "abc-123", e.Meeting.InternalMeetingID)
+				assert.True(t, e.User.SharingMic)
+			},
+		},
+		{
+			name:  "camera broadcast",
+			event: `{"data":{"type":"event","id":"user-cam-broadcast-start","attributes":{"meeting":{"internal-meeting-id":"abc-123"},"user":{"internal-user-id":"w_1","stream":"w_1-cam-1"}},"event":{"ts":1}}}`,
+			check: func(t *testing.T, p webhooks.Payload) {
+				e, ok := p.(*webhooks.UserCamBroadcastStart)
+				require.True(t, ok)
+				assert.Equal(t, "w_1-cam-1", e.User.Stream)
+			},
+		},
+		{
+			name:  "presenter assigned",
+			event: `{"data":{"type":"event","id":"user-presenter-assigned","attributes":{"meeting":{"internal-meeting-id":"abc-123"},"user":{"internal-user-id":"w_1","presenter":true}},"event":{"ts":1}}}`,
+			check: func(t *testing.T, p webhooks.Payload) {
+				e, ok := p.(*webhooks.UserPresenterAssigned)
+				require.True(t, ok)
+				assert.True(t, e.User.Presenter)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, p := parseOne(t, tt.event)
+			tt.check(t, p)
+		})
+	}
+}
+
+func TestDecode_ChatMessage(t *testing.T) {
+	_, p := parseOne(t, `{"data":{"type":"event","id":"chat-group-message-sent","attributes":{"meeting":{"internal-meeting-id":"abc-123"},"chat-id":"MAIN-PUBLIC-GROUP-CHAT","chat-message":{"id":"m1","message":"Hello","sender":{"internal-user-id":"w_1","name":"Jane","time":1502810165000}}},"event":{"ts":1}}}`)
+
+	e, ok := p.(*webhooks.ChatGroupMessageSent)
+	require.True(t, ok)
+	assert.Equal(t, webhooks.EventChatGroupMessageSent, e.EventName())
+	assert.Equal(t, "MAIN-PUBLIC-GROUP-CHAT", e.ChatID)
+	assert.Equal(t, "Hello", e.Message.Message)
+	assert.Equal(t, "Jane", e.Message.Sender.Name)
+}
+
+func TestDecode_RecordingEvents(t *testing.T) {
+	_, p := parseOne(t, `{"data":{"type":"event","id":"rap-publish-ended","attributes":{"meeting":{"internal-meeting-id":"abc-123","external-meeting-id":"test123"},"record-id":"abc-123","success":true,"step-time":1200,"workflow":"presentation","recording":{"name":"Test","is-breakout":false,"start-time":1502810164922,"end-time":1502810200000,"size":2048,"raw-size":4096,"metadata":{"course":"math-101"},"playback":{"format":"presentation","link":"https://bbb.example.com/playback/presentation/2.3/abc-123","processing-time":5000,"duration":35000}}},"event":{"ts":1}}}`)
+
+	e, ok := p.(*webhooks.RapPublishEnded)
+	require.True(t, ok)
+	assert.Equal(t, "abc-123", e.RecordID)
+	assert.True(t, e.Success)
+	assert.Equal(t, "presentation", e.Workflow)
+	require.NotNil(t, e.Recording)
+	assert.Equal(t, int64(4096), e.Recording.RawSize)
+	assert.Equal(t, "math-101", e.Recording.Metadata["course"])
+	require.NotNil(t, e.Recording.Playback)
+	assert.Equal(t, int64(35000), e.Recording.Playback.Duration)
+
+	_, p = parseOne(t, `{"data":{"type":"event","id":"rap-deleted","attributes":{"meeting":{"internal-meeting-id":"abc-123"},"record-id":"abc-123"},"event":{"ts":1}}}`)
+	_, ok = p.(*webhooks.RapDeleted)
+	assert.True(t, ok)
+}
+
+func TestDecode_UnknownEvents(t *testing.T) {
+	field := `{"data":{"type":"event","id":"pad-content","attributes":{"pad":{"id":"p1"}},"event":{"ts":1}}}`
+	_, p := parseOne(t, field)
+
+	u, ok := p.(*webhooks.Unknown)
+	require.True(t, ok)
+	assert.Equal(t, "pad-content", u.EventName())
+	assert.JSONEq(t, field, string(u.Raw))
+	assert.False(t, webhooks.KnownEvent("pad-content"))
+	assert.True(t, webhooks.KnownEvent(webhooks.EventRapArchiveEnded))
+	assert.True(t, webhooks.ValidEvent("pad-content"), "valid filters include events without a typed payload")
+	assert.True(t, webhooks.ValidEvent("User-Joined"))
+	assert.False(t, webhooks.ValidEvent("meeting-exploded"))
+
+	_, p = parseOne(t, `{"envelope":{"name":"UserJoinedMeetingEvtMsg","timestamp":1},"core":{"header":{"meetingId":"abc-123"},"body":{}}}`)
+	u, ok = p.(*webhooks.Unknown)
+	require.True(t, ok)
+	assert.Equal(t, "UserJoinedMeetingEvtMsg", u.Name)
+}
+
+func TestDecode_HeaderPayloadFormat(t *testing.T) {
+	e, p := parseOne(t, `{"header":{"name":"meeting-created","timestamp":"2023-01-01T00:00:00Z"},"payload":{"meeting":{"id":"meeting-123","name":"Test Meeting","recorded":true}}}`)
+
+	assert.Equal(t, webhooks.FormatMapped, e.Format)
+	assert.True(t, e.Timestamp.Equal(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	created, ok := p.(*webhooks.MeetingCreated)
+	require.True(t, ok)
+	assert.Equal(t, "meeting-123", created.Meeting.ExternalMeetingID)
+	assert.Equal(t, "Test Meeting", created.Meeting.Name)
+	assert.True(t, created.Meeting.Record)
+
+	_, err := webhooks.ParseEvents(`{"header":{"name":"meeting-created","timestamp":"yesterday"},"payload":{}}`)
+	require.Error(t, err)
+}
+
+func TestHandler_OnPayload(t *testing.T) {
+	var names []string
+	h := &webhooks.Handler{
+		Secret:      testSecret,
+		CallbackURL: testCallbackURL,
+		OnPayload: func(ctx context.Context, e webhooks.Event, p webhooks.Payload) error {
+			names = append(names, p.EventName())
+			return nil
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, mappedEvents))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{webhooks.EventMeetingCreated, webhooks.EventUserJoined}, names)
+}
@@ -17,6 +17,7 @@ func TestCreateWebhook(t *testing.T) {
 		assert.Equal(t, "/api/hooks/create", r.URL.Path)
 		assert.Equal(t, "https://example.com/callback", r.URL.Query().Get("callbackURL"))
 		assert.Equal(t, "meeting_ended", r.URL.Query().Get("meetingID"))
+		assert.Equal(t, "meeting-ended,user-left", r.URL.Query().Get("eventID"))
 
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(`
@@ -32,6 +33,7 @@ func TestCreateWebhook(t *testing.T) {
 	req := &requests.CreateHookRequest{
 		CallbackURL: "https://example.com/callback",
 		MeetingID:   "meeting_ended",
+		EventIDs:    []string{"meeting-ended", "user-left"},
 	}
 
 	resp, err := client.CreateHook(context.Background(), req)
@@ -56,7 +58,12 @@ func TestListWebhooks(t *testing.T) {
 		      <hookID>hook-123</hookID>
 		      <callbackURL>https://example.com/callback</callbackURL>
 		      <meetingID>meeting_ended</meetingID>
+		      <eventID>user-joined,user-left</eventID>
 		      <permanentHook>false</permanentHook>
+		      <rawData>true</rawData>
+		      <metadata>
+		        <service>analytics</service>
+		      </metadata>
 		    </hook>
 		  </hooks>
 		</response>`))
@@ -69,6 +76,10 @@ func TestListWebhooks(t *testing.T) {
 	require.Len(t, resp.Hooks, 1)
 	assert.Equal(t, "hook-123", resp.Hooks[0].ID)
 	assert.Equal(t, "https://example.com/callback", resp.Hooks[0].CallbackURL)
+	assert.Equal(t, "analytics", resp.Hooks[0].Metadata["service"])
+	assert.Equal(t, []string{"user-joined", "user-left"}, resp.Hooks[0].EventIDs())
+	assert.True(t, resp.Hooks[0].Raw)
+	assert.False(t, resp.Hooks[0].Permanent)
 }
 
 // -------------------- DestroyWebhook --------------------
@@ -89,3 +100,63 @@ func TestDestroyWebhook(t *testing.T) {
 	assert.Equal(t, "SUCCESS", resp.ReturnCode)
 	assert.True(t, resp.Removed)
 }
+
+// -------------------- UpdateWebhook --------------------
+func TestUpdateWebhook(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/hooks/update", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "hook-123", q.Get("hookID"))
+		assert.Equal(t, "https://example.com/new", q.Get("callbackURL"))
+		assert.Equal(t, "meeting-created,RAP-Publish-Ended", q.Get("eventID"), "event IDs are matched case-insensitively")
+		assert.Equal(t, "true", q.Get("getRaw"))
+		assert.Equal(t, "lms", q.Get("meta_source"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<response><returncode>SUCCESS</returncode><hookID>hook-123</hookID><permanentHook>false</permanentHook><rawData>true</rawData></response>`))
+	})
+
+	resp, err := client.UpdateHook(context.Background(), &requests.UpdateHookRequest{
+		HookID:      "hook-123",
+		CallbackURL: "https://example.com/new",
+		EventIDs:    []string{"meeting-created", "RAP-Publish-Ended"},
+		GetRaw:      requests.Bool(true),
+		Meta:        map[string]string{"source": "lms"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "hook-123", resp.HookID)
+	assert.True(t, resp.Raw)
+}
+
+func TestWebhookValidation(t *testing.T) {
+	client := bbb.NewTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request expected")
+	})
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"update nil request", func() error { _, err := client.UpdateHook(ctx, nil); return err }},
+		{"update missing hookID", func() error {
+			_, err := client.UpdateHook(ctx, &requests.UpdateHookRequest{CallbackURL: "https://example.com"})
+			return err
+		}},
+		{"update unknown event", func() error {
+			_, err := client.UpdateHook(ctx, &requests.UpdateHookRequest{HookID: "1", EventIDs: []string{"meeting-exploded"}})
+			return err
+		}},
+		{"create unknown event", func() error {
+			_, err := client.CreateHook(ctx, &requests.CreateHookRequest{CallbackURL: "https://example.com", EventIDs: []string{"user-joined", "bogus"}})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Error(t, tt.call())
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+)
+
+// config is the content of the configuration file.
+type config struct {
+	Listen   string         `json:"listen"`
+	Secret   string         `json:"secret"`
+	Strategy string         `json:"strategy"`
+	Store    string         `json:"store"`
+	Servers  []serverConfig `json:"servers"`
+}
+
+// serverConfig describes a backend BigBlueButton server.
+type serverConfig struct {
+	ID     string `json:"id"`
+	URL    string `json:"url"`
+	Secret string `json:"secret"`
+	Weight int    `json:"weight"`
+}
+
+// strategies maps the strategy names of the configuration file to their constructors.
+var strategies = map[string]func() bbb.Strategy{
+	"":                   bbb.LeastMeetings,
+	"least-meetings":     bbb.LeastMeetings,
+	"least-participants": bbb.LeastParticipants,
+	"round-robin":        bbb.RoundRobin,
+	"weighted":           bbb.Weighted,
+}
+
+// loadConfig reads and validates the configuration file.
+func loadConfig(path string) (*config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config: %w", err)
+	}
+
+	cfg := &config{Listen: ":8080"}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	if cfg.Secret == "" {
+		return nil, fmt.Errorf("config: secret is required")
+	}
+	if _, ok := strategies[cfg.Strategy]; !ok {
+		return nil, fmt.Errorf("config: unknown strategy %q", cfg.Strategy)
+	}
+	if len(cfg.Servers) == 0 {
+		return nil, fmt.Errorf("config: at least one server is required")
+	}
+	for _, s := range cfg.Servers {
+		if s.ID == "" || s.URL == "" || s.Secret == "" {
+			return nil, fmt.Errorf("config: servers need an id, url and secret")
+		}
+	}
+
+	return cfg, nil
+}
+
+// newPool creates the pool of backend servers described by the configuration.
+func (cfg *config) newPool() (*bbb.Pool, error) {
+	servers := make([]*bbb.Server, len(cfg.Servers))
+	for i, s := range cfg.Servers {
+		client, err := bbb.NewClient(s.URL, s.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("server %s: %w", s.ID, err)
+		}
+		servers[i] = &bbb.Server{ID: s.ID, Client: client, Weight: s.Weight}
+	}
+
+	options := []bbb.PoolOption{bbb.WithStrategy(strategies[cfg.Strategy]())}
+	if cfg.Store != "" {
+		store, err := bbb.NewFileStore(cfg.Store)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, bbb.WithMeetingStore(store))
+	}
+
+	return bbb.NewPool(servers, options...)
+}
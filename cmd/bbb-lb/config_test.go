@@ -0,0 +1,61 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------- Config --------------------
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "bbb-lb.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"secret": "lb-secret",
+		"strategy": "weighted",
+		"store": "`+filepath.Join(dir, "meetings.json")+`",
+		"servers": [
+			{"id": "bbb1", "url": "https://bbb1.example.com/bigbluebutton/", "secret": "s1", "weight": 2},
+			{"id": "bbb2", "url": "https://bbb2.example.com/bigbluebutton/", "secret": "s2"}
+		]
+	}`), 0o600))
+
+	cfg, err := loadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, ":8080", cfg.Listen)
+
+	pool, err := cfg.newPool()
+	require.NoError(t, err)
+	require.Len(t, pool.Servers(), 2)
+	assert.Equal(t, 2, pool.Server("bbb1").Weight)
+}
+
+func TestLoadConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+	}{
+		{"malformed", `{`},
+		{"missing secret", `{"servers": [{"id": "a", "url": "https://a", "secret": "s"}]}`},
+		{"unknown strategy", `{"secret": "x", "strategy": "random", "servers": [{"id": "a", "url": "https://a", "secret": "s"}]}`},
+		{"no servers", `{"secret": "x"}`},
+		{"incomplete server", `{"secret": "x", "servers": [{"id": "a"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "bbb-lb.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.config), 0o600))
+
+			_, err := loadConfig(path)
+			require.Error(t, err)
+		})
+	}
+
+	_, err := loadConfig(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
@@ -0,0 +1,409 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+)
+
+// apiPrefix is the path under which the BigBlueButton API is served.
+const apiPrefix = "/bigbluebutton/api"
+
+// maxBodySize limits the size of POST bodies, e.g. presentations sent on create.
+const maxBodySize = 64 << 20
+
+// errNotCreated reports a create call the backend answered with FAILED.
+var errNotCreated = errors.New("meeting not created")
+
+// handler serves the BigBlueButton API and proxies calls to the pool's servers.
+type handler struct {
+	pool    *bbb.Pool
+	secret  string
+	logger  *slog.Logger
+	maxBody int64
+}
+
+// newHandler returns the HTTP handler of the load balancer.
+func newHandler(pool *bbb.Pool, secret string, logger *slog.Logger) http.Handler {
+	return &handler{pool: pool, secret: secret, logger: logger, maxBody: maxBodySize}
+}
+
+// response is the XML envelope of the responses generated by the load balancer.
+type response struct {
+	XMLName    xml.Name `xml:"response"`
+	ReturnCode string   `xml:"returncode"`
+	MessageKey string   `xml:"messageKey,omitempty"`
+	Message    string   `xml:"message,omitempty"`
+	Version    string   `xml:"version,omitempty"`
+	Running    *bool    `xml:"running,omitempty"`
+	Inner      []byte   `xml:",innerxml"`
+	Total      *int     `xml:"totalElements,omitempty"`
+}
+
+// ServeHTTP implements http.Handler.
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != apiPrefix && !strings.HasPrefix(r.URL.Path, apiPrefix+"/") {
+		http.NotFound(w, r)
+		return
+	}
+	action := strings.Trim(strings.TrimPrefix(r.URL.Path, apiPrefix), "/")
+
+	if action == "" {
+		writeXML(w, response{ReturnCode: "SUCCESS", Version: "2.0"})
+		return
+	}
+
+	if !bbb.VerifyChecksum(action, r.URL.RawQuery, h.secret) {
+		writeFailed(w, "checksumError", "Checksums do not match")
+		return
+	}
+
+	params := r.URL.Query()
+	params.Del("checksum")
+	ctx := r.Context()
+
+	switch action {
+	case "create":
+		h.create(ctx, w, r, params)
+	case "join":
+		h.join(ctx, w, r, params)
+	case "isMeetingRunning":
+		h.isMeetingRunning(ctx, w, params)
+	case "end", "getMeetingInfo", "insertDocument":
+		h.proxyMeeting(ctx, w, action, params)
+	case "getMeetings":
+		h.gather(ctx, w, action, params, "meetings", "noMeetings", "no meetings were found on this server")
+	case "getRecordings":
+		h.recordings(ctx, w, params)
+	case "publishRecordings", "deleteRecordings", "updateRecordings":
+		h.broadcast(ctx, w, action, params)
+	default:
+		writeFailed(w, "unsupportedRequest", "This request is not supported.")
+	}
+}
+
+// create creates a meeting on the server chosen by the pool, forwarding any POST body.
+// Concurrent creates of the same meeting are serialized by the pool.
+func (h *handler) create(ctx context.Context, w http.ResponseWriter, r *http.Request, params url.Values) {
+	meetingID := params.Get("meetingID")
+	if meetingID == "" {
+		writeFailed(w, "missingParamMeetingID", "You must specify a meeting ID for the meeting.")
+		return
+	}
+
+	var body []byte
+	if r.Method == http.MethodPost {
+		var err error
+		body, err = io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBody))
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeFailed(w, "invalidRequest", fmt.Sprintf("The request body exceeds the limit of %d bytes.", tooLarge.Limit))
+			return
+		}
+		if err != nil {
+			writeFailed(w, "invalidRequest", "The request body could not be read.")
+			return
+		}
+	}
+
+	var resp []byte
+	_, err := h.pool.CreateWith(ctx, meetingID, func(ctx context.Context, s *bbb.Server) error {
+		var err error
+		if len(body) > 0 {
+			resp, err = s.Client.CallWithBody(ctx, "create", params, r.Header.Get("Content-Type"), body)
+		} else {
+			resp, err = s.Client.Call(ctx, "create", params)
+		}
+		if err == nil && returnCode(resp) != "SUCCESS" {
+			err = errNotCreated
+		}
+		return err
+	})
+	if err != nil && !errors.Is(err, errNotCreated) {
+		h.fail(w, "create", err)
+		return
+	}
+	writeRaw(w, resp)
+}
+
+// join redirects to the join URL of the server hosting the meeting, or
+// proxies the call when the application asked for redirect=false.
+func (h *handler) join(ctx context.Context, w http.ResponseWriter, r *http.Request, params url.Values) {
+	s, ok := h.serverFor(ctx, w, "join", params)
+	if !ok {
+		return
+	}
+
+	if params.Get("redirect") == "false" {
+		h.proxy(ctx, w, s, "join", params)
+		return
+	}
+	http.Redirect(w, r, s.Client.SignedURL("join", params), http.StatusFound)
+}
+
+// isMeetingRunning proxies the call, answering false for meetings no server knows.
+func (h *handler) isMeetingRunning(ctx context.Context, w http.ResponseWriter, params url.Values) {
+	s, err := h.pool.ServerFor(ctx, params.Get("meetingID"))
+	if errors.Is(err, bbb.ErrAPINotFound) {
+		running := false
+		writeXML(w, response{ReturnCode: "SUCCESS", Running: &running})
+		return
+	}
+	if err != nil {
+		h.fail(w, "isMeetingRunning", err)
+		return
+	}
+	h.proxy(ctx, w, s, "isMeetingRunning", params)
+}
+
+// proxyMeeting proxies a call about a meeting to the server hosting it.
+func (h *handler) proxyMeeting(ctx context.Context, w http.ResponseWriter, action string, params url.Values) {
+	s, ok := h.serverFor(ctx, w, action, params)
+	if !ok {
+		return
+	}
+
+	resp, ok := h.proxy(ctx, w, s, action, params)
+	if ok && action == "end" && returnCode(resp) == "SUCCESS" {
+		if err := h.pool.Forget(ctx, params.Get("meetingID")); err != nil {
+			h.logger.ErrorContext(ctx, "forgetting meeting", slog.String("meetingID", params.Get("meetingID")), slog.Any("error", err))
+		}
+	}
+}
+
+// serverFor returns the server hosting the meeting of a call, writing the
+// error response when there is none.
+func (h *handler) serverFor(ctx context.Context, w http.ResponseWriter, action string, params url.Values) (*bbb.Server, bool) {
+	meetingID := params.Get("meetingID")
+	if meetingID == "" {
+		writeFailed(w, "missingParamMeetingID", "You must specify a meeting ID for the meeting.")
+		return nil, false
+	}
+
+	s, err := h.pool.ServerFor(ctx, meetingID)
+	if errors.Is(err, bbb.ErrAPINotFound) {
+		writeFailed(w, "notFound", "We could not find a meeting with that meeting ID")
+		return nil, false
+	}
+	if err != nil {
+		h.fail(w, action, err)
+		return nil, false
+	}
+	return s, true
+}
+
+// proxy sends a call to a server and writes its response as received.
+func (h *handler) proxy(ctx context.Context, w http.ResponseWriter, s *bbb.Server, action string, params url.Values) ([]byte, bool) {
+	resp, err := s.Client.Call(ctx, action, params)
+	if err != nil {
+		h.fail(w, action, err)
+		return nil, false
+	}
+	writeRaw(w, resp)
+	return resp, true
+}
+
+// gather sends a listing call to every server and merges the children of
+// the element named list from all successful responses.
+func (h *handler) gather(ctx context.Context, w http.ResponseWriter, action string, params url.Values, list, emptyKey, emptyMessage string) {
+	writeList(w, list, h.collect(ctx, action, params, list), emptyKey, emptyMessage, nil)
+}
+
+// recordings merges the recordings of every server and pages the merged list,
+// since paging each server separately would return up to one page per server.
+func (h *handler) recordings(ctx context.Context, w http.ResponseWriter, params url.Values) {
+	offset, _ := strconv.Atoi(params.Get("offset"))
+	limit, _ := strconv.Atoi(params.Get("limit"))
+	params.Del("offset")
+	params.Del("limit")
+
+	items := h.collect(ctx, "getRecordings", params, "recordings")
+	total := len(items)
+
+	if offset < 0 || offset > len(items) {
+		offset = len(items)
+	}
+	items = items[offset:]
+	if limit > 0 && limit < len(items) {
+		items = items[:limit]
+	}
+
+	writeList(w, "recordings", items, "noRecordings", "There are no recordings for the meeting(s).", &total)
+}
+
+// collect sends a listing call to every server and returns the children of
+// the element named list from all successful responses.
+func (h *handler) collect(ctx context.Context, action string, params url.Values, list string) [][]byte {
+	var items [][]byte
+	for _, resp := range h.callAll(ctx, action, params) {
+		if returnCode(resp) == "SUCCESS" {
+			items = append(items, children(innerXML(resp, list))...)
+		}
+	}
+	return items
+}
+
+// writeList writes a SUCCESS response listing items in the element named list,
+// with the given message when there are none and the total if it is set.
+func writeList(w http.ResponseWriter, list string, items [][]byte, emptyKey, emptyMessage string, total *int) {
+	out := response{ReturnCode: "SUCCESS", Total: total}
+	if len(items) == 0 {
+		out.MessageKey = emptyKey
+		out.Message = emptyMessage
+	}
+	out.Inner = append(append([]byte("<"+list+">"), bytes.Join(items, nil)...), "</"+list+">"...)
+	writeXML(w, out)
+}
+
+// broadcast sends a recording call to every server, since recordings may live
+// on any of them, and writes the first successful response.
+func (h *handler) broadcast(ctx context.Context, w http.ResponseWriter, action string, params url.Values) {
+	results := h.callAll(ctx, action, params)
+
+	var last []byte
+	for _, resp := range results {
+		if resp == nil {
+			continue
+		}
+		if returnCode(resp) == "SUCCESS" {
+			writeRaw(w, resp)
+			return
+		}
+		last = resp
+	}
+
+	if last == nil {
+		writeFailed(w, "internalError", "No server could be reached.")
+		return
+	}
+	writeRaw(w, last)
+}
+
+// callAll sends a call to every server concurrently. The responses are in the
+// order of the servers, with nil for servers that failed.
+func (h *handler) callAll(ctx context.Context, action string, params url.Values) [][]byte {
+	servers := h.pool.Servers()
+	results := make([][]byte, len(servers))
+
+	var wg sync.WaitGroup
+	for i, s := range servers {
+		wg.Add(1)
+		go func(i int, s *bbb.Server) {
+			defer wg.Done()
+			resp, err := s.Client.Call(ctx, action, params)
+			if err != nil {
+				h.logger.WarnContext(ctx, "backend call failed", slog.String("server", s.ID), slog.String("action", action), slog.Any("error", err))
+				return
+			}
+			results[i] = resp
+		}(i, s)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// fail logs an error and writes a FAILED response for it.
+func (h *handler) fail(w http.ResponseWriter, action string, err error) {
+	h.logger.Error("api call failed", slog.String("action", action), slog.Any("error", err))
+
+	var apiErr *bbb.APIError
+	if errors.As(err, &apiErr) && apiErr.MessageKey != "" {
+		writeFailed(w, apiErr.MessageKey, apiErr.Message)
+		return
+	}
+	if errors.Is(err, bbb.ErrNoServer) {
+		writeFailed(w, "noAvailableServer", "No server is available to create the meeting.")
+		return
+	}
+	writeFailed(w, "internalError", "An internal error occurred.")
+}
+
+// returnCode returns the return code of an XML response, or "" if it cannot be parsed.
+func returnCode(body []byte) string {
+	var r struct {
+		ReturnCode string `xml:"returncode"`
+	}
+	if xml.Unmarshal(body, &r) != nil {
+		return ""
+	}
+	return r.ReturnCode
+}
+
+// innerXML returns the content of the child element name of an XML response.
+func innerXML(body []byte, name string) []byte {
+	d := xml.NewDecoder(bytes.NewReader(body))
+	depth := 0
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 2 && t.Name.Local == name {
+				var el struct {
+					Inner []byte `xml:",innerxml"`
+				}
+				if d.DecodeElement(&el, &t) != nil {
+					return nil
+				}
+				return el.Inner
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
+
+// children splits XML content into its top-level elements, dropping the text between them.
+func children(inner []byte) [][]byte {
+	var out [][]byte
+	d := xml.NewDecoder(bytes.NewReader(inner))
+	for {
+		start := d.InputOffset()
+		tok, err := d.Token()
+		if err != nil {
+			return out
+		}
+		if _, ok := tok.(xml.StartElement); ok {
+			if d.Skip() != nil {
+				return out
+			}
+			out = append(out, inner[start:d.InputOffset()])
+		}
+	}
+}
+
+// writeFailed writes a FAILED response.
+func writeFailed(w http.ResponseWriter, messageKey, message string) {
+	writeXML(w, response{ReturnCode: "FAILED", MessageKey: messageKey, Message: message})
+}
+
+// writeXML writes a response generated by the load balancer.
+func writeXML(w http.ResponseWriter, r response) {
+	data, err := xml.Marshal(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeRaw(w, data)
+}
+
+// writeRaw writes an XML response body.
+func writeRaw(w http.ResponseWriter, body []byte) {
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.Write(body)
+}
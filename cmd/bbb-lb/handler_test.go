@@ -0,0 +1,317 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/amirazad1/bigbluebutton-api-go/bbb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const lbSecret = "lb-secret"
+
+// backend is a BigBlueButton server keeping its meetings and recordings in memory.
+type backend struct {
+	mu         sync.Mutex
+	meetings   map[string]bool
+	recordings []string
+	bodies     []string
+	secret     string
+}
+
+func (b *backend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	action := strings.TrimPrefix(r.URL.Path, "/bigbluebutton/api/")
+	if !bbb.VerifyChecksum(action, r.URL.RawQuery, b.secret) {
+		w.Write([]byte(`<response><returncode>FAILED</returncode><messageKey>checksumError</messageKey></response>`))
+		return
+	}
+
+	q := r.URL.Query()
+	id := q.Get("meetingID")
+	switch action {
+	case "create":
+		body, _ := io.ReadAll(r.Body)
+		b.bodies = append(b.bodies, string(body))
+		b.meetings[id] = true
+		fmt.Fprintf(w, `<response><returncode>SUCCESS</returncode><meetingID>%s</meetingID></response>`, id)
+	case "getMeetingInfo", "end":
+		if !b.meetings[id] {
+			w.Write([]byte(`<response><returncode>FAILED</returncode><messageKey>notFound</messageKey><message>not found</message></response>`))
+			return
+		}
+		if action == "end" {
+			delete(b.meetings, id)
+		}
+		fmt.Fprintf(w, `<response><returncode>SUCCESS</returncode><meetingID>%s</meetingID></response>`, id)
+	case "isMeetingRunning":
+		fmt.Fprintf(w, `<response><returncode>SUCCESS</returncode><running>%t</running></response>`, b.meetings[id])
+	case "getMeetings":
+		var s strings.Builder
+		for id := range b.meetings {
+			fmt.Fprintf(&s, `<meeting><meetingID>%s</meetingID></meeting>`, id)
+		}
+		fmt.Fprintf(w, `<response><returncode>SUCCESS</returncode><meetings>%s</meetings></response>`, s.String())
+	case "getRecordings":
+		recordings := b.recordings
+		if offset, _ := strconv.Atoi(q.Get("offset")); offset < len(recordings) {
+			recordings = recordings[offset:]
+		} else {
+			recordings = nil
+		}
+		if limit, _ := strconv.Atoi(q.Get("limit")); limit > 0 && limit < len(recordings) {
+			recordings = recordings[:limit]
+		}
+		var s strings.Builder
+		for _, id := range recordings {
+			fmt.Fprintf(&s, `<recording><recordID>%s</recordID></recording>`, id)
+		}
+		fmt.Fprintf(w, `<response><returncode>SUCCESS</returncode><recordings>%s</recordings></response>`, s.String())
+	case "publishRecordings":
+		for _, id := range b.recordings {
+			if id == q.Get("recordID") {
+				w.Write([]byte(`<response><returncode>SUCCESS</returncode><published>true</published></response>`))
+				return
+			}
+		}
+		w.Write([]byte(`<response><returncode>FAILED</returncode><messageKey>notFound</messageKey></response>`))
+	}
+}
+
+// newTestLB starts the backends and returns a load balancer in front of them.
+func newTestLB(t *testing.T, backends ...*backend) *httptest.Server {
+	t.Helper()
+
+	lb := httptest.NewServer(newTestHandler(t, backends...))
+	t.Cleanup(lb.Close)
+	return lb
+}
+
+// newTestHandler starts the backends and returns the handler of a load balancer in front of them.
+func newTestHandler(t *testing.T, backends ...*backend) *handler {
+	t.Helper()
+
+	servers := make([]*bbb.Server, len(backends))
+	for i, b := range backends {
+		b.secret = fmt.Sprintf("secret-%d", i)
+		if b.meetings == nil {
+			b.meetings = map[string]bool{}
+		}
+		ts := httptest.NewServer(b)
+		t.Cleanup(ts.Close)
+
+		client, err := bbb.NewClient(ts.URL+"/bigbluebutton/", b.secret)
+		require.NoError(t, err)
+		servers[i] = &bbb.Server{ID: fmt.Sprintf("bbb%d", i), Client: client}
+	}
+
+	pool, err := bbb.NewPool(servers, bbb.WithStrategy(bbb.RoundRobin()))
+	require.NoError(t, err)
+
+	return newHandler(pool, lbSecret, slog.New(slog.NewTextHandler(io.Discard, nil))).(*handler)
+}
+
+// signedURL returns the URL of a call to the load balancer signed with SHA-256.
+func signedURL(lb *httptest.Server, action string, params url.Values) string {
+	query := params.Encode()
+	sum := sha256.Sum256([]byte(action + query + lbSecret))
+	return lb.URL + apiPrefix + "/" + action + "?" + query + "&checksum=" + hex.EncodeToString(sum[:])
+}
+
+// get performs a call to the load balancer without following redirects.
+func get(t *testing.T, rawURL string) (*http.Response, string) {
+	t.Helper()
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Get(rawURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(body)
+}
+
+// -------------------- Handler --------------------
+
+func TestHandler_Version(t *testing.T) {
+	lb := newTestLB(t, &backend{})
+
+	_, body := get(t, lb.URL+apiPrefix)
+	assert.Contains(t, body, "<returncode>SUCCESS</returncode>")
+	assert.Contains(t, body, "<version>2.0</version>")
+}
+
+func TestHandler_RejectsBadChecksum(t *testing.T) {
+	lb := newTestLB(t, &backend{})
+
+	_, body := get(t, lb.URL+apiPrefix+"/getMeetings?checksum=0123456789012345678901234567890123456789")
+	assert.Contains(t, body, "<messageKey>checksumError</messageKey>")
+
+	_, body = get(t, lb.URL+apiPrefix+"/getMeetings")
+	assert.Contains(t, body, "<messageKey>checksumError</messageKey>")
+}
+
+func TestHandler_MeetingLifecycle(t *testing.T) {
+	b0, b1 := &backend{}, &backend{}
+	lb := newTestLB(t, b0, b1)
+
+	// Round robin places the meetings on bbb0 and bbb1.
+	for _, id := range []string{"m0", "m1"} {
+		_, body := get(t, signedURL(lb, "create", url.Values{"meetingID": {id}, "name": {"Meeting " + id}}))
+		assert.Contains(t, body, "<returncode>SUCCESS</returncode>")
+	}
+	assert.True(t, b0.meetings["m0"])
+	assert.True(t, b1.meetings["m1"])
+
+	// Recreating a meeting goes to the server hosting it.
+	get(t, signedURL(lb, "create", url.Values{"meetingID": {"m1"}, "name": {"Meeting m1"}}))
+	assert.Len(t, b1.bodies, 2)
+
+	_, body := get(t, signedURL(lb, "getMeetingInfo", url.Values{"meetingID": {"m1"}}))
+	assert.Contains(t, body, "<meetingID>m1</meetingID>")
+
+	_, body = get(t, signedURL(lb, "getMeetings", url.Values{}))
+	assert.Contains(t, body, "<meetingID>m0</meetingID>")
+	assert.Contains(t, body, "<meetingID>m1</meetingID>")
+
+	resp, _ := get(t, signedURL(lb, "join", url.Values{"meetingID": {"m1"}, "fullName": {"Jane"}, "role": {"VIEWER"}}))
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	location := resp.Header.Get("Location")
+	u, err := url.Parse(location)
+	require.NoError(t, err)
+	assert.Equal(t, "/bigbluebutton/api/join", u.Path)
+	assert.True(t, bbb.VerifyChecksum("join", u.RawQuery, b1.secret), "join URL is signed with the backend secret")
+
+	_, body = get(t, signedURL(lb, "end", url.Values{"meetingID": {"m1"}}))
+	assert.Contains(t, body, "<returncode>SUCCESS</returncode>")
+	assert.False(t, b1.meetings["m1"])
+
+	_, body = get(t, signedURL(lb, "isMeetingRunning", url.Values{"meetingID": {"m1"}}))
+	assert.Contains(t, body, "<running>false</running>")
+
+	_, body = get(t, signedURL(lb, "getMeetingInfo", url.Values{"meetingID": {"m1"}}))
+	assert.Contains(t, body, "<messageKey>notFound</messageKey>")
+}
+
+func TestHandler_CreateWithPresentation(t *testing.T) {
+	b0 := &backend{}
+	lb := newTestLB(t, b0)
+
+	doc := `<modules><module name="presentation"><document url="https://example.com/slides.pdf"/></module></modules>`
+	resp, err := http.Post(signedURL(lb, "create", url.Values{"meetingID": {"m0"}}), "application/xml", strings.NewReader(doc))
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	require.Len(t, b0.bodies, 1)
+	assert.Equal(t, doc, b0.bodies[0])
+}
+
+func TestHandler_CreateBodyTooLarge(t *testing.T) {
+	b0 := &backend{}
+	h := newTestHandler(t, b0)
+	h.maxBody = 16
+	lb := httptest.NewServer(h)
+	defer lb.Close()
+
+	doc := `<modules><module name="presentation"><document url="https://example.com/slides.pdf"/></module></modules>`
+	resp, err := http.Post(signedURL(lb, "create", url.Values{"meetingID": {"m0"}}), "application/xml", strings.NewReader(doc))
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	assert.Contains(t, string(body), "<returncode>FAILED</returncode>")
+	assert.Empty(t, b0.bodies, "a truncated body is not forwarded")
+	assert.False(t, b0.meetings["m0"])
+}
+
+func TestHandler_CreateConcurrent(t *testing.T) {
+	b0, b1 := &backend{}, &backend{}
+	lb := newTestLB(t, b0, b1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := http.Get(signedURL(lb, "create", url.Values{"meetingID": {"room"}, "name": {"Room"}}))
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, b0.meetings["room"] != b1.meetings["room"], "the meeting is created on exactly one server")
+}
+
+func TestHandler_Recordings(t *testing.T) {
+	b0 := &backend{recordings: []string{"r0"}}
+	b1 := &backend{recordings: []string{"r1"}}
+	lb := newTestLB(t, b0, b1)
+
+	_, body := get(t, signedURL(lb, "getRecordings", url.Values{}))
+	assert.Contains(t, body, "<recordID>r0</recordID>")
+	assert.Contains(t, body, "<recordID>r1</recordID>")
+
+	_, body = get(t, signedURL(lb, "publishRecordings", url.Values{"recordID": {"r1"}, "publish": {"true"}}))
+	assert.Contains(t, body, "<published>true</published>")
+
+	_, body = get(t, signedURL(lb, "publishRecordings", url.Values{"recordID": {"missing"}, "publish": {"true"}}))
+	assert.Contains(t, body, "<messageKey>notFound</messageKey>")
+
+	empty := newTestLB(t, &backend{})
+	_, body = get(t, signedURL(empty, "getRecordings", url.Values{}))
+	assert.Contains(t, body, "<messageKey>noRecordings</messageKey>")
+	assert.Contains(t, body, "<totalElements>0</totalElements>")
+}
+
+func TestHandler_RecordingsPaging(t *testing.T) {
+	b0 := &backend{recordings: []string{"r0", "r1", "r2"}}
+	b1 := &backend{recordings: []string{"r3", "r4"}}
+	lb := newTestLB(t, b0, b1)
+
+	ids := func(body string) []string {
+		var resp struct {
+			IDs   []string `xml:"recordings>recording>recordID"`
+			Total int      `xml:"totalElements"`
+		}
+		require.NoError(t, xml.Unmarshal([]byte(body), &resp))
+		assert.Equal(t, 5, resp.Total)
+		return resp.IDs
+	}
+
+	_, body := get(t, signedURL(lb, "getRecordings", url.Values{"offset": {"2"}, "limit": {"2"}}))
+	assert.Equal(t, []string{"r2", "r3"}, ids(body), "pages are cut from the merged list")
+
+	_, body = get(t, signedURL(lb, "getRecordings", url.Values{"offset": {"4"}, "limit": {"2"}}))
+	assert.Equal(t, []string{"r4"}, ids(body))
+
+	_, body = get(t, signedURL(lb, "getRecordings", url.Values{"offset": {"10"}}))
+	assert.Empty(t, ids(body))
+	assert.Contains(t, body, "<messageKey>noRecordings</messageKey>")
+
+	_, body = get(t, signedURL(lb, "getRecordings", url.Values{}))
+	assert.Equal(t, []string{"r0", "r1", "r2", "r3", "r4"}, ids(body))
+}
+
+func TestHandler_Unsupported(t *testing.T) {
+	lb := newTestLB(t, &backend{})
+
+	_, body := get(t, signedURL(lb, "launchRocket", url.Values{}))
+	assert.Contains(t, body, "<messageKey>unsupportedRequest</messageKey>")
+}
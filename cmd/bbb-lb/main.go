@@ -0,0 +1,97 @@
+/*
+Command bbb-lb is a load balancer exposing the BigBlueButton API in front of
+several BigBlueButton servers, compatible with applications written for a
+single server or for Scalelite.
+
+Applications sign their calls with the load balancer's own secret. Meetings are
+created on the server chosen by the configured strategy and every later call
+about a meeting is proxied to the server hosting it. The meeting-to-server
+mapping is kept in memory or, when a store file is configured, on disk.
+
+Usage:
+
+	bbb-lb -config /etc/bbb-lb.json
+
+The configuration file is JSON:
+
+	{
+	  "listen": ":8080",
+	  "secret": "load-balancer-secret",
+	  "strategy": "least-participants",
+	  "store": "/var/lib/bbb-lb/meetings.json",
+	  "servers": [
+	    {"id": "bbb1", "url": "https://bbb1.example.com/bigbluebutton/", "secret": "…", "weight": 2},
+	    {"id": "bbb2", "url": "https://bbb2.example.com/bigbluebutton/", "secret": "…"}
+	  ]
+	}
+
+Strategies are least-meetings (the default), least-participants, round-robin
+and weighted.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+func main() {
+	configPath := flag.String("config", "bbb-lb.json", "path to the configuration file")
+	flag.Parse()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+	if err := run(*configPath, logger); err != nil {
+		logger.Error("bbb-lb failed", slog.Any("error", err))
+		os.Exit(1)
+	}
+}
+
+// run loads the configuration and serves the API until SIGINT or SIGTERM.
+func run(configPath string, logger *slog.Logger) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	pool, err := cfg.newPool()
+	if err != nil {
+		return err
+	}
+
+	srv := &http.Server{
+		Addr:              cfg.Listen,
+		Handler:           newHandler(pool, cfg.Secret, logger),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errc := make(chan error, 1)
+	go func() {
+		logger.Info("bbb-lb listening", slog.String("addr", cfg.Listen), slog.Int("servers", len(cfg.Servers)))
+		errc <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}